@@ -37,3 +37,22 @@ func CopyInterfaces(val []interface{}) []interface{} {
 	}
 	return tmp
 }
+
+// CopyAs tries to make a deep copy of val and keeps its type T.
+//
+// It uses Copy; it returns val itself if the copy cannot be converted back to T (e.g., []int).
+func CopyAs[T any](val T) T {
+	if tmp, ok := Copy(val).(T); ok {
+		return tmp
+	}
+	return val
+}
+
+// CopySlice tries to make a deep copy of a []T object.
+func CopySlice[T any](val []T) []T {
+	tmp := make([]T, len(val))
+	for i := range val {
+		tmp[i] = CopyAs(val[i])
+	}
+	return tmp
+}
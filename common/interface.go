@@ -9,3 +9,86 @@ type Value interface {
 	String() string     // stringify
 	Copy() interface{}  // makes a deep copy
 }
+
+// IteratorOf the interface for lazy iterators / cursors over values of type T
+//
+// Next returns the next value and true, or the zero value of T and false if there are no more values.
+type IteratorOf[T any] interface {
+	Next() (T, bool)
+}
+
+// Iterator the interface for lazy iterators / cursors over interface{} values
+type Iterator = IteratorOf[interface{}]
+
+// MergeableHeap the interface for min-heaps supporting decrease-key by handles & union, e.g., FibonacciHeap,
+// PairingHeap and BinomialHeap
+//
+// T is the type of values, N is the type of the handles returned by Insert, and H is the type of the heap itself.
+//
+// Minimum and ExtractMin return the zero value of N (i.e., nil) if the heap is empty. Union moves all the nodes into
+// the returned heap, and both old heaps become empty.
+type MergeableHeap[T any, N any, H any] interface {
+	Insert(val T) N
+	Minimum() N
+	ExtractMin() N
+	DecreaseKey(node N, newVal T) error
+	Delete(node N) error
+	Union(other H) H
+	Len() int
+	IsEmpty() bool
+	Values() []T
+}
+
+// ContainerOf the interface for all the structures holding values of type T
+//
+// Values returns a new slice of all the values; the order depends on the structure, e.g., ascending for trees.
+type ContainerOf[T any] interface {
+	Len() int
+	IsEmpty() bool
+	Values() []T
+}
+
+// Container the interface for all the structures holding interface{} values
+type Container = ContainerOf[interface{}]
+
+// BagOf the interface for the containers which pop values in the order of pushing, e.g., Stack (LIFO) and
+// Queue (FIFO)
+//
+// Pop returns the zero value of T if the container is empty; please check HasNext first.
+type BagOf[T any] interface {
+	ContainerOf[T]
+	Push(v T)
+	Pop() T
+	HasNext() bool
+}
+
+// Bag the interface for the bags of interface{} values
+type Bag = BagOf[interface{}]
+
+// OrderedSetOf the interface for the sets keeping the values in ascending order, e.g., RedBlackTree
+//
+// Insert returns false if the value already exists; Delete returns false if the value does not exist.
+type OrderedSetOf[T any] interface {
+	ContainerOf[T]
+	Insert(val T) bool
+	Delete(val T) bool
+	Contains(val T) bool
+	Range(lo, hi T, inclusiveLo, inclusiveHi bool) []T
+}
+
+// OrderedSet the interface for the ordered sets of interface{} values
+type OrderedSet = OrderedSetOf[interface{}]
+
+// PriorityQueueOf the interface for the structures popping the values by priority, e.g., PriorityQ and the heaps
+//
+// Whether the minimum or the maximum comes first depends on the structure; see their AsPriorityQueue methods.
+// Peek and Pop return an error if the queue is empty.
+type PriorityQueueOf[T any] interface {
+	ContainerOf[T]
+	Push(v T)
+	Peek() (T, error)
+	Pop() (T, error)
+}
+
+// PriorityQueue the interface for the priority queues of interface{} values
+type PriorityQueue = PriorityQueueOf[interface{}]
@@ -0,0 +1,30 @@
+package common
+
+// SliceIterator an iterator over the values of a slice
+//
+// IMPORTANT: use NewSliceIterator to create a new SliceIterator object
+type SliceIterator[T any] struct {
+	values []T
+	index int
+}
+
+// Next returns the next value and true, or the zero value of T and false if there are no more values.
+func (it *SliceIterator[T]) Next() (T, bool) {
+	if it.index >= len(it.values) {
+		var zero T
+		return zero, false
+	}
+	v := it.values[it.index]
+	it.index ++
+	return v, true
+}
+
+// Len returns the number of values that are not visited yet.
+func (it *SliceIterator[T]) Len() int {
+	return len(it.values) - it.index
+}
+
+// NewSliceIterator returns an iterator over the values; note that it does not copy the slice.
+func NewSliceIterator[T any](values []T) *SliceIterator[T] {
+	return &SliceIterator[T]{values: values}
+}
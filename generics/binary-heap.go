@@ -0,0 +1,175 @@
+package generics
+
+import (
+	"errors"
+	"some-data-structures/common"
+)
+
+// BinaryHeap
+//
+// The binary heap structure. Please use NewBinaryHeapWithValues() or NewBinaryHeap() as the safe constructor.
+//
+// Attributes:
+//
+// Heap []T. Should start from index 1 as index 0 is reserved.
+//
+// .
+//
+// compare is the function for comparing different node values;
+// it should return 1 if a > b , 0 if a == b, -1 if a < b
+type BinaryHeap[T any] struct {
+	top     int // the rightmost boundary (inclusive)
+	Heap    []T
+	compare func(a, b T) int
+}
+
+func (bh *BinaryHeap[T]) Size() int {
+	return bh.top
+}
+
+// Len returns the number of values in the heap; same as Size.
+func (bh *BinaryHeap[T]) Len() int {
+	return bh.top
+}
+
+// IsEmpty returns true if the heap has no values.
+func (bh *BinaryHeap[T]) IsEmpty() bool {
+	return bh.top < 1
+}
+
+// Values returns the values in the heap; the order is not specified.
+func (bh *BinaryHeap[T]) Values() []T {
+	r := make([]T, bh.top)
+	copy(r, bh.Heap[1:bh.top + 1])
+	return r
+}
+
+func (bh *BinaryHeap[T]) swap(i, j int) {
+	bh.Heap[i], bh.Heap[j] = bh.Heap[j], bh.Heap[i]
+}
+
+// maintains the order of the binary heap
+//
+// Parameters:
+//
+// top: The index of the current root node of the subtree.
+func (bh *BinaryHeap[T]) maxHeapify(i int) {
+	left := i << 1
+	right := left + 1
+	var largest int
+	if left <= bh.top && bh.compare(bh.Heap[left], bh.Heap[i]) == 1 {
+		largest = left
+	} else {
+		largest = i
+	}
+	if right <= bh.top && bh.compare(bh.Heap[right], bh.Heap[largest]) == 1 {
+		largest = right
+	}
+	if largest != i {
+		bh.swap(i, largest)
+		bh.maxHeapify(largest)
+	}
+}
+
+// sorts the heap and turns it into a binary heap.
+func (bh *BinaryHeap[T]) build() {
+	for i := bh.top / 2; i > 0; i -- {
+		bh.maxHeapify(i)
+	}
+}
+
+// Heapsort sorts this binary heap into an ordered array. The order is reverse to the order of the binary heap. For
+// example, if the binary heap is a maximum binary heap, then the order produce by Heapsort is ascending.
+//
+// It modifies the BinaryHeap object itself.
+func (bh *BinaryHeap[T]) Heapsort() {
+	for i := bh.top; i > 1; i -- {
+		bh.swap(i, 1)
+		bh.top--
+		bh.maxHeapify(1)
+	}
+	bh.top = len(bh.Heap) - 1 // reset
+}
+
+// HeapMaximum returns the maximum (or minimum, depending on the compare method) element from the binary heap
+func (bh *BinaryHeap[T]) HeapMaximum() (T, error) {
+	if bh.top < 1 {
+		var zero T
+		return zero, errors.New(errorNoElement)
+	}
+	return bh.Heap[1], nil
+}
+
+// ExtractHeapMaximum extracts and returns the maximum (or minimum, depending on the compare method)
+// element from the binary heap.
+//
+// This operation will modify the binary heap.
+func (bh *BinaryHeap[T]) ExtractHeapMaximum() (T, error) {
+	if bh.top < 1 {
+		var zero T
+		return zero, errors.New(errorNoElement)
+	}
+	max := bh.Heap[1]            //todo: consider making a deep copy here
+	bh.Heap[1] = bh.Heap[bh.top] // so the old bh.Heap[1] is no longer in the binary heap
+	bh.top--
+	bh.maxHeapify(1)
+	return max, nil
+}
+
+// updates the key at position top
+func (bh *BinaryHeap[T]) updateKey(i int, key T) error {
+	if i > bh.top {
+		return errors.New(errorInvalidIndex)
+	}
+	if bh.compare(bh.Heap[i], key) == 1 {
+		return errors.New(errorKeyValue)
+	}
+	bh.Heap[i] = key
+	for i > 1 && bh.compare(bh.Heap[i], bh.Heap[i / 2]) == 1 {
+		bh.swap(i, i / 2)
+		i = i / 2
+	}
+	return nil
+}
+
+// Insert inserts a key to the proper position in the binary heap.
+func (bh *BinaryHeap[T]) Insert(key T) error {
+	bh.top++
+	if bh.top >= len(bh.Heap) { // needs to extend
+		more := make([]T, defaultSize)
+		bh.Heap = append(bh.Heap, more...)
+	}
+	bh.Heap[bh.top] = key
+	return bh.updateKey(bh.top, key)
+}
+
+// Copy makes a deep copy.
+//
+// Only the values in the heap are deep copied; the reserved index 0 is kept as it is, and the unused slots are
+// dropped, as they may hold nil pointers.
+func (bh *BinaryHeap[T]) Copy() *BinaryHeap[T] {
+	heap := append([]T{bh.Heap[0]}, common.CopySlice(bh.Heap[1:bh.top + 1])...)
+	return &BinaryHeap[T]{Heap: heap, compare: bh.compare, top: bh.top}
+}
+
+// NewBinaryHeap returns a new BinaryHeap object with no initial values.
+//
+// compare is the function for comparing different node values;
+// it should return 1 if a > b , 0 if a == b, -1 if a < b.
+func NewBinaryHeap[T any](compare func(a, b T) int) *BinaryHeap[T] {
+	return &BinaryHeap[T]{Heap: make([]T, defaultSize), compare: compare, top: 0}
+}
+
+// NewBinaryHeapWithValues returns a new BinaryHeap object with initial values.
+//
+// The values are copied, so changes of values after this creation will not affect the heap.
+//
+// compare is the function for comparing different node values;
+// it should return 1 if a > b , 0 if a == b, -1 if a < b.
+func NewBinaryHeapWithValues[T any](values []T, compare func(a, b T) int) *BinaryHeap[T] {
+	newValues := make([]T, len(values) + 1)  // index 0 is reserved
+	copy(newValues[1:], values)
+	bh := &BinaryHeap[T]{Heap: newValues, compare: compare, top: len(values)}
+	bh.build()
+	return bh
+}
@@ -0,0 +1,425 @@
+package generics
+
+// BinarySearchTree
+//
+// The binary search tree. Please use NewBSTree() as the safe constructor.
+//
+// Attributes:
+//
+//	Root *TreeNode[T]
+//
+//	compare func(a, b T) int
+//
+// .
+//
+// compare is the function for comparing different node values;
+// it should return 1 if a > b , 0 if a == b, -1 if a < b.
+//
+// .
+//
+// Both inputs of the compare function are of type T. When T is interface{}, the second input may have variant types,
+// and a tricky compare method can relax the conditions for Search and Delete; see examples for details.
+//
+// .
+//
+// Note that this BinarySearchTree does not perform type checking; please include any necessary type checking
+// in the customized compare function.
+type BinarySearchTree[T any] struct {
+	Root    *TreeNode[T]
+	compare func(a, b T) int
+	num     int  // track number of nodes in the tree
+}
+
+// InOrderTreeWalk returns all the values of the tree in an in-order-tree-walk manner.
+func (bt *BinarySearchTree[T]) InOrderTreeWalk() []T {
+	r := make([]T, 0)
+	var inorder func(node *TreeNode[T])
+	inorder = func(node *TreeNode[T]) {
+		if node != nil {
+			inorder(node.Left)
+			r = append(r, node.Val)
+			inorder(node.Right)
+		}
+	}
+	inorder(bt.Root)
+	return r
+}
+
+// Len returns the number of nodes in the tree.
+func (bt *BinarySearchTree[T]) Len() int {
+	return bt.num
+}
+
+// IsEmpty returns true if the tree has no nodes.
+func (bt *BinarySearchTree[T]) IsEmpty() bool {
+	return bt.Root == nil
+}
+
+// Values returns all the values of the tree in ascending order; same as InOrderTreeWalk.
+func (bt *BinarySearchTree[T]) Values() []T {
+	return bt.InOrderTreeWalk()
+}
+
+// Contains returns true if the val exists in the tree.
+func (bt *BinarySearchTree[T]) Contains(val T) bool {
+	_, b := bt.Search(val)
+	return b
+}
+
+// Search returns the pointer to the FIRST corresponding TreeNode if that TreeNode exists in the tree.
+func (bt *BinarySearchTree[T]) Search(val T) (*TreeNode[T], bool) {
+	cur := bt.Root
+	for {
+		if cur == nil {
+			break
+		}
+		c := bt.compare(cur.Val, val)
+		if c == 0 {
+			return cur, true
+		} else if c == 1 {  // cur.Val > val
+			cur = cur.Left
+		} else {
+			cur = cur.Right
+		}
+	}
+	return nil, false
+}
+
+// MaxSince returns the pointer to the max (rightmost) TreeNode in the subtree since the current node.
+func (bt *BinarySearchTree[T]) MaxSince(node *TreeNode[T]) *TreeNode[T] {
+	cur := node
+	for {
+		if cur == nil || cur.Right == nil {
+			break
+		}
+		cur = cur.Right
+	}
+	return cur
+}
+
+// MinSince returns the pointer to the min (leftmost) TreeNode in the subtree since the current node.
+func (bt *BinarySearchTree[T]) MinSince(node *TreeNode[T]) *TreeNode[T] {
+	cur := node
+	for {
+		if cur == nil || cur.Left == nil {
+			break
+		}
+		cur = cur.Left
+	}
+	return cur
+}
+
+// Max returns the pointer to the max (rightmost) TreeNode in the tree.
+func (bt *BinarySearchTree[T]) Max() *TreeNode[T] {
+	return bt.MaxSince(bt.Root)
+}
+
+// Min returns the pointer to the min (leftmost) TreeNode in the tree.
+func (bt *BinarySearchTree[T]) Min() *TreeNode[T] {
+	return bt.MinSince(bt.Root)
+}
+
+// Successor find the minimum tree node that is bigger than (to the right of) the current node.
+//
+// It will return nil if the current node is nil.
+func (bt *BinarySearchTree[T]) Successor(node *TreeNode[T]) *TreeNode[T] {
+	if node == nil {
+		return nil
+	}
+	if node.Right != nil {
+		return bt.MinSince(node.Right)
+	}
+	y := node.Parent
+	x := node
+	for y != nil && x == y.Right {
+		x = y
+		y = y.Parent
+	}
+	return y
+}
+
+// Predecessor find the maximum tree node that is smaller than (to the left of) the current node.
+//
+// It will return nil if the current node is nil.
+func (bt *BinarySearchTree[T]) Predecessor(node *TreeNode[T]) *TreeNode[T] {
+	if node == nil {
+		return nil
+	}
+	if node.Left != nil {
+		return bt.MaxSince(node.Left)
+	}
+	y := node.Parent
+	x := node
+	for y != nil && x == y.Left {
+		x = y
+		y = y.Parent
+	}
+	return y
+}
+
+// returns the smallest node that is bigger than val (strict) or bigger than or equal to val (not strict).
+func (bt *BinarySearchTree[T]) ceiling(val T, strict bool) (*TreeNode[T], bool) {
+	var r *TreeNode[T]
+	cur := bt.Root
+	for cur != nil {
+		c := bt.compare(cur.Val, val)
+		if c == 1 || (c == 0 && !strict) {  // cur is a candidate; look for a smaller one
+			r = cur
+			cur = cur.Left
+		} else {
+			cur = cur.Right
+		}
+	}
+	return r, r != nil
+}
+
+// returns the biggest node that is smaller than val (strict) or smaller than or equal to val (not strict).
+func (bt *BinarySearchTree[T]) floor(val T, strict bool) (*TreeNode[T], bool) {
+	var r *TreeNode[T]
+	cur := bt.Root
+	for cur != nil {
+		c := bt.compare(cur.Val, val)
+		if c == -1 || (c == 0 && !strict) {  // cur is a candidate; look for a bigger one
+			r = cur
+			cur = cur.Right
+		} else {
+			cur = cur.Left
+		}
+	}
+	return r, r != nil
+}
+
+// Ceiling returns the smallest node whose value is bigger than or equal to val.
+func (bt *BinarySearchTree[T]) Ceiling(val T) (*TreeNode[T], bool) {
+	return bt.ceiling(val, false)
+}
+
+// Higher returns the smallest node whose value is strictly bigger than val.
+func (bt *BinarySearchTree[T]) Higher(val T) (*TreeNode[T], bool) {
+	return bt.ceiling(val, true)
+}
+
+// Floor returns the biggest node whose value is smaller than or equal to val.
+func (bt *BinarySearchTree[T]) Floor(val T) (*TreeNode[T], bool) {
+	return bt.floor(val, false)
+}
+
+// Lower returns the biggest node whose value is strictly smaller than val.
+func (bt *BinarySearchTree[T]) Lower(val T) (*TreeNode[T], bool) {
+	return bt.floor(val, true)
+}
+
+// Range returns all the values between lo and hi in an ascending order.
+//
+// inclusiveLo and inclusiveHi decide whether the values equal to lo and hi are included.
+//
+// It starts from the Ceiling (or Higher) node and follows the successors, so it costs O(log n + k).
+func (bt *BinarySearchTree[T]) Range(lo, hi T, inclusiveLo, inclusiveHi bool) []T {
+	r := make([]T, 0)
+	node, _ := bt.ceiling(lo, !inclusiveLo)
+	for node != nil {
+		c := bt.compare(node.Val, hi)
+		if c == 1 || (c == 0 && !inclusiveHi) {
+			break
+		}
+		r = append(r, node.Val)
+		node = bt.Successor(node)
+	}
+	return r
+}
+
+func (bt *BinarySearchTree[T]) insert(val T, safe bool) bool {
+	node := NewTreeNode(val)
+	if bt.Root == nil {
+		bt.Root = node
+		bt.num ++
+		return true
+	}
+
+	cur := bt.Root
+	for {
+		c := bt.compare(cur.Val, val)
+		if c == 1 { // cur.Val > val
+			if cur.Left == nil {
+				cur.Left = node
+				node.Parent = cur
+				break
+			} else {
+				cur = cur.Left
+			}
+		} else {
+			if c == 0 && safe {
+				return false
+			}
+			if cur.Right == nil {
+				cur.Right = node
+				node.Parent = cur
+				break
+			} else {
+				cur = cur.Right
+			}
+		}
+	}
+	bt.num ++
+	return true
+}
+
+// Insert inserts a new val as a new node.
+//
+// Does not insert if the val already exists in the tree.
+func (bt *BinarySearchTree[T]) Insert(val T) bool {
+	return bt.insert(val, true)
+}
+
+// UnsafeInsert inserts a new val as a new node and allows the same val to be inserted for multiple times.
+func (bt *BinarySearchTree[T]) UnsafeInsert(val T) {
+	bt.insert(val, false)
+}
+
+// uses subtree n2 to replace subtree n1 by connecting n2 and the parent of n1.
+//
+// It does not update the child of n1 or n2.
+func (bt *BinarySearchTree[T]) transplant(n1, n2 *TreeNode[T]) {
+	if n1.Parent == nil {
+		bt.Root = n2
+	} else if n1 == n1.Parent.Left {
+		n1.Parent.Left = n2
+	} else {
+		n1.Parent.Right = n2
+	}
+	if n2 != nil {
+		n2.Parent = n1.Parent
+	}
+}
+
+// DeleteNode deletes the node from the tree.
+//
+// it returns a boolean value indicating if the deletion is successful.
+func (bt *BinarySearchTree[T]) DeleteNode(node *TreeNode[T]) bool {
+	if node == nil {
+		return false
+	}
+
+	if node.Left == nil {  // if the node does not have a left child (it may also do not have a right child)
+		bt.transplant(node, node.Right)
+	} else if node.Right == nil {  // does not have a right child (but must have a left child)
+		bt.transplant(node, node.Left)
+	} else {  // has both right child and left child
+		y := bt.MinSince(node.Right)  // the min node that is bigger than the current node
+		if y.Parent != node {
+			bt.transplant(y, y.Right)
+			y.Right = node.Right
+			y.Right.Parent = y
+		}
+		bt.transplant(node, y)
+		y.Left = node.Left
+		y.Left.Parent = y
+	}
+
+	bt.num --
+	return true
+}
+
+// Delete deletes the First node with the corresponding value if it exists.
+//
+// it returns a boolean value indicating if the deletion is successful.
+func (bt *BinarySearchTree[T]) Delete(v T) bool {
+	node, _ := bt.Search(v)
+	return bt.DeleteNode(node)
+}
+
+// Height returns the height of the tree.
+//
+// Warning: it uses dfs and is expensive.
+func (bt *BinarySearchTree[T]) Height() int {
+	max := 0
+	count := 0
+	var dfs func(cur *TreeNode[T])
+	dfs = func(cur *TreeNode[T]) {
+		if cur != nil {
+			count ++
+			if count > max {
+				max = count
+			}
+			dfs(cur.Left)
+			dfs(cur.Right)
+			count --
+		}
+	}
+	dfs(bt.Root)
+	return max
+}
+
+// Rebuild returns a tree with the same set of elements that are in different order and the distribution will be more
+// condense.
+func (bt *BinarySearchTree[T]) Rebuild() *BinarySearchTree[T] {
+	values := bt.InOrderTreeWalk()
+
+	newTree := NewBSTree(bt.compare)
+
+	newValues := make([]T, len(values))
+	curIndex := 0
+
+	var rearrange func(left, right int)
+	rearrange = func(left, right int) {
+		if right >= left {
+			mid := (left + right) / 2
+			newValues[curIndex] = values[mid]
+			curIndex += 1
+			rearrange(left, mid - 1)
+			rearrange(mid + 1, right)
+		}
+	}
+
+	rearrange(0, len(values) - 1)
+
+	for _, val := range newValues {
+		newTree.Insert(val)
+	}
+
+	return newTree
+}
+
+// NewBSTree returns a new BinarySearchTree
+func NewBSTree[T any](compare func(a, b T) int) *BinarySearchTree[T] {
+	return &BinarySearchTree[T]{compare: compare}
+}
+
+// Iterator returns a cursor walking through the values in an ascending order.
+//
+// The values are visited lazily by following the successors; modifying the tree invalidates the cursor.
+func (bt *BinarySearchTree[T]) Iterator() *BSTIterator[T] {
+	return &BSTIterator[T]{tree: bt, node: bt.Min()}
+}
+
+// ReverseIterator returns a cursor walking through the values in a descending order.
+//
+// The values are visited lazily by following the predecessors; modifying the tree invalidates the cursor.
+func (bt *BinarySearchTree[T]) ReverseIterator() *BSTIterator[T] {
+	return &BSTIterator[T]{tree: bt, node: bt.Max(), reverse: true}
+}
+
+// BSTIterator
+//
+// The cursor over a BinarySearchTree. Please use BinarySearchTree.Iterator() or BinarySearchTree.ReverseIterator()
+// to create one.
+type BSTIterator[T any] struct {
+	tree *BinarySearchTree[T]
+	node *TreeNode[T]  // the next node to visit; will be nil if there are no more values
+	reverse bool
+}
+
+// Next returns the next value and true, or the zero value of T and false if there are no more values.
+func (it *BSTIterator[T]) Next() (T, bool) {
+	if it.node == nil {
+		var zero T
+		return zero, false
+	}
+	v := it.node.Val
+	if it.reverse {
+		it.node = it.tree.Predecessor(it.node)
+	} else {
+		it.node = it.tree.Successor(it.node)
+	}
+	return v, true
+}
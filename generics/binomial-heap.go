@@ -0,0 +1,229 @@
+package generics
+
+import (
+	"errors"
+)
+
+// BinomialHeap
+//
+// The binomial heap structure, which is an ordered min-heap. Please use NewBinomialHeap() as the safe constructor.
+//
+// It has the same API as FibonacciHeap, but all the operations, including Insert and Union, cost O(log n) in the
+// worst case instead of amortized.
+//
+// .
+//
+// compare is the function for comparing different node values;
+// it should return 1 if a > b , 0 if a == b, -1 if a < b.
+type BinomialHeap[T any] struct {
+	n int
+	head *binomialTree[T]  // the root list, sorted by degree in ascending order
+	compare func(a, b T) int
+}
+
+// NumOfElements returns the number of nodes in the heap.
+func (bh *BinomialHeap[T]) NumOfElements() int {
+	return bh.n
+}
+
+// makes y a child of z; both of them are roots with the same degree.
+func binomialLink[T any](y, z *binomialTree[T]) {
+	y.parent = z
+	y.sibling = z.child
+	z.child = y
+	z.degree ++
+}
+
+// merges 2 root lists into one sorted by degree.
+func mergeBinomialRoots[T any](a, b *binomialTree[T]) *binomialTree[T] {
+	dummy := &binomialTree[T]{}
+	tail := dummy
+	for a != nil && b != nil {
+		if a.degree <= b.degree {
+			tail.sibling, a = a, a.sibling
+		} else {
+			tail.sibling, b = b, b.sibling
+		}
+		tail = tail.sibling
+	}
+	if a != nil {
+		tail.sibling = a
+	} else {
+		tail.sibling = b
+	}
+	return dummy.sibling
+}
+
+// unites 2 root lists and returns the new one, in which no 2 roots have the same degree.
+func (bh *BinomialHeap[T]) union(a, b *binomialTree[T]) *binomialTree[T] {
+	head := mergeBinomialRoots(a, b)
+	if head == nil {
+		return nil
+	}
+	var prev *binomialTree[T]
+	x := head
+	next := x.sibling
+	for next != nil {
+		if x.degree != next.degree || (next.sibling != nil && next.sibling.degree == x.degree) {
+			prev, x = x, next
+		} else if bh.compare(x.item.Val, next.item.Val) != 1 {
+			x.sibling = next.sibling
+			binomialLink(next, x)
+		} else {
+			if prev == nil {
+				head = next
+			} else {
+				prev.sibling = next
+			}
+			binomialLink(x, next)
+			x = next
+		}
+		next = x.sibling
+	}
+	return head
+}
+
+// removes the root from the root list, and unites its children back into the heap.
+func (bh *BinomialHeap[T]) removeRoot(root *binomialTree[T]) {
+	if bh.head == root {
+		bh.head = root.sibling
+	} else {
+		prev := bh.head
+		for prev.sibling != root {
+			prev = prev.sibling
+		}
+		prev.sibling = root.sibling
+	}
+	// the children are sorted by degree in descending order, so reverses them
+	var children *binomialTree[T]
+	for child := root.child; child != nil; {
+		next := child.sibling
+		child.parent = nil
+		child.sibling = children
+		children = child
+		child = next
+	}
+	bh.head = bh.union(bh.head, children)
+	root.item.tree = nil
+	bh.n --
+}
+
+// moves the value of the tree node t up while it is smaller than its parent's (or all the way up to the root if
+// force is true), and returns the tree node holding it at last.
+func (bh *BinomialHeap[T]) bubbleUp(t *binomialTree[T], force bool) *binomialTree[T] {
+	for p := t.parent; p != nil && (force || bh.compare(t.item.Val, p.item.Val) == -1); p = t.parent {
+		t.item, p.item = p.item, t.item
+		t.item.tree = t
+		p.item.tree = p
+		t = p
+	}
+	return t
+}
+
+// returns the root holding the minimum.
+func (bh *BinomialHeap[T]) minRoot() *binomialTree[T] {
+	m := bh.head
+	for x := bh.head; x != nil; x = x.sibling {
+		if bh.compare(x.item.Val, m.item.Val) == -1 {
+			m = x
+		}
+	}
+	return m
+}
+
+// Insert inserts a new val into the heap and returns a pointer to the inserted node.
+func (bh *BinomialHeap[T]) Insert(val T) *BinomialNode[T] {
+	item := &BinomialNode[T]{Val: val}
+	item.tree = &binomialTree[T]{item: item}
+	bh.head = bh.union(bh.head, item.tree)
+	bh.n ++
+	return item
+}
+
+// Minimum returns the min node of the heap; it won't change the heap.
+func (bh *BinomialHeap[T]) Minimum() *BinomialNode[T] {
+	if bh.head == nil {
+		return nil
+	}
+	return bh.minRoot().item
+}
+
+// ExtractMin pops out the minimum node of the heap; it will change the heap.
+func (bh *BinomialHeap[T]) ExtractMin() *BinomialNode[T] {
+	if bh.head == nil {
+		return nil
+	}
+	root := bh.minRoot()
+	item := root.item
+	bh.removeRoot(root)
+	return item
+}
+
+// DecreaseKey decrease the val of a node to newVal.
+func (bh *BinomialHeap[T]) DecreaseKey(node *BinomialNode[T], newVal T) error {
+	if node == nil || node.tree == nil {
+		return errors.New(errorNoElement)
+	}
+	if bh.compare(newVal, node.Val) == 1 {
+		return errors.New("new key cannot be greater than the old key")
+	}
+	node.Val = newVal
+	bh.bubbleUp(node.tree, false)
+	return nil
+}
+
+// Delete deletes a node from the heap.
+//
+// It returns an error if the node is not in the heap, e.g., it has been extracted or deleted.
+func (bh *BinomialHeap[T]) Delete(node *BinomialNode[T]) error {
+	if node == nil || node.tree == nil {
+		return errors.New(errorNoElement)
+	}
+	bh.removeRoot(bh.bubbleUp(node.tree, true))
+	return nil
+}
+
+// Union returns the union of the 2 binomial heaps in O(log n).
+//
+// The nodes are moved into the new heap, so the pointers returned by Insert still work on the new heap;
+// both old heaps become empty.
+func (bh *BinomialHeap[T]) Union(other *BinomialHeap[T]) *BinomialHeap[T] {
+	h := NewBinomialHeap(bh.compare)
+	h.head, h.n = bh.head, bh.n
+	if other != bh {
+		h.head = h.union(h.head, other.head)
+		h.n += other.n
+	}
+	bh.head, bh.n = nil, 0
+	other.head, other.n = nil, 0
+	return h
+}
+
+// Len returns the number of nodes in the heap.
+func (bh *BinomialHeap[T]) Len() int {
+	return bh.n
+}
+
+// IsEmpty returns true if the heap has no nodes.
+func (bh *BinomialHeap[T]) IsEmpty() bool {
+	return bh.n == 0
+}
+
+// Values returns all the values in the heap; the order is not specified.
+func (bh *BinomialHeap[T]) Values() []T {
+	r := make([]T, 0, bh.n)
+	var walk func(t *binomialTree[T])
+	walk = func(t *binomialTree[T]) {
+		for ; t != nil; t = t.sibling {
+			r = append(r, t.item.Val)
+			walk(t.child)
+		}
+	}
+	walk(bh.head)
+	return r
+}
+
+// NewBinomialHeap returns an empty BinomialHeap object.
+func NewBinomialHeap[T any](compare func(a, b T) int) *BinomialHeap[T] {
+	return &BinomialHeap[T]{compare: compare}
+}
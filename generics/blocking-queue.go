@@ -0,0 +1,189 @@
+package generics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrQueueClosed is returned when a value is pushed into a closed BlockingQueue, or popped from a closed and empty one.
+var ErrQueueClosed = errors.New("the queue is closed")
+
+// BlockingQueue
+//
+// The bounded FIFO queue which is safe for concurrent use. Please use NewBlockingQueue() as the safe constructor.
+//
+// It is a Queue guarded by a mutex with a fixed capacity: Push blocks while the queue is full and Pop blocks while
+// it is empty, until the context is canceled or its deadline is exceeded.
+//
+// Similar to a channel, after Close, Push fails while Pop still returns the values left in the queue, and then fails
+// with ErrQueueClosed once the queue is empty.
+type BlockingQueue[T any] struct {
+	mu sync.Mutex
+	queue *Queue[T]
+	capacity int
+	closed bool
+	notEmpty chan struct{}  // closed and replaced when the queue becomes non-empty, to wake up the blocked Pop
+	notFull chan struct{}  // closed and replaced when the queue becomes non-full, to wake up the blocked Push
+}
+
+// wakes up all the goroutines waiting on the channel, and returns a new channel for the next waiters.
+func broadcast(ch chan struct{}) chan struct{} {
+	close(ch)
+	return make(chan struct{})
+}
+
+// Len returns the number of values in the queue.
+func (bq *BlockingQueue[T]) Len() int {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	return bq.queue.Len()
+}
+
+// Cap returns the capacity of the queue.
+func (bq *BlockingQueue[T]) Cap() int {
+	return bq.capacity
+}
+
+// IsClosed returns true if the queue is closed.
+func (bq *BlockingQueue[T]) IsClosed() bool {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	return bq.closed
+}
+
+// pushes v if the queue is not full, and returns true if v is pushed; bq.mu must be held.
+func (bq *BlockingQueue[T]) tryPush(v T) bool {
+	if bq.closed || bq.queue.Len() >= bq.capacity {
+		return false
+	}
+	bq.queue.Push(v)
+	if bq.queue.Len() == 1 {  // empty -> non-empty
+		bq.notEmpty = broadcast(bq.notEmpty)
+	}
+	return true
+}
+
+// pops a value if the queue is not empty; bq.mu must be held.
+func (bq *BlockingQueue[T]) tryPop() (T, bool) {
+	if !bq.queue.HasNext() {
+		var zero T
+		return zero, false
+	}
+	v := bq.queue.Pop()
+	if bq.queue.Len() == bq.capacity - 1 {  // full -> non-full
+		bq.notFull = broadcast(bq.notFull)
+	}
+	return v, true
+}
+
+// Push pushes v into the queue, and blocks while the queue is full.
+//
+// It returns ErrQueueClosed if the queue is closed, or the error of the context if the context is done before v is
+// pushed.
+func (bq *BlockingQueue[T]) Push(ctx context.Context, v T) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		bq.mu.Lock()
+		if bq.closed {
+			bq.mu.Unlock()
+			return ErrQueueClosed
+		}
+		if bq.tryPush(v) {
+			bq.mu.Unlock()
+			return nil
+		}
+		notFull := bq.notFull
+		bq.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-notFull:
+		}
+	}
+}
+
+// Pop pops out and returns the first element of the queue, and blocks while the queue is empty.
+//
+// It returns ErrQueueClosed if the queue is closed and empty, or the error of the context if the context is done
+// before a value is popped.
+func (bq *BlockingQueue[T]) Pop(ctx context.Context) (T, error) {
+	var zero T
+	for {
+		if err := ctx.Err(); err != nil {
+			return zero, err
+		}
+		bq.mu.Lock()
+		if v, b := bq.tryPop(); b {
+			bq.mu.Unlock()
+			return v, nil
+		}
+		if bq.closed {
+			bq.mu.Unlock()
+			return zero, ErrQueueClosed
+		}
+		notEmpty := bq.notEmpty
+		bq.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			return zero, ctx.Err()
+		case <-notEmpty:
+		}
+	}
+}
+
+// TryPush pushes v without blocking, and returns false if the queue is full or closed.
+func (bq *BlockingQueue[T]) TryPush(v T) bool {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	return bq.tryPush(v)
+}
+
+// TryPop pops out the first element without blocking, and returns false if the queue is empty.
+func (bq *BlockingQueue[T]) TryPop() (T, bool) {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	return bq.tryPop()
+}
+
+// Drain pops out and returns all the values in the queue without blocking, e.g., to handle the values left after
+// Close during a graceful shutdown.
+func (bq *BlockingQueue[T]) Drain() []T {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	r := bq.queue.Values()
+	if len(r) > 0 {
+		bq.queue.Empty()
+		bq.notFull = broadcast(bq.notFull)
+	}
+	return r
+}
+
+// Close closes the queue and wakes up all the blocked goroutines; it returns ErrQueueClosed if the queue is already
+// closed.
+func (bq *BlockingQueue[T]) Close() error {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	if bq.closed {
+		return ErrQueueClosed
+	}
+	bq.closed = true
+	bq.notEmpty = broadcast(bq.notEmpty)
+	bq.notFull = broadcast(bq.notFull)
+	return nil
+}
+
+// NewBlockingQueue returns an empty BlockingQueue object holding at most capacity values.
+//
+// capacity must > 0; otherwise it will return nil.
+func NewBlockingQueue[T any](capacity int) *BlockingQueue[T] {
+	if capacity < 1 {
+		fmt.Println("the capacity must be > 0")
+		return nil
+	}
+	return &BlockingQueue[T]{queue: NewQueue[T](), capacity: capacity, notEmpty: make(chan struct{}),
+		notFull: make(chan struct{})}
+}
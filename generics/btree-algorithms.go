@@ -0,0 +1,436 @@
+package generics
+
+// bTreeNodes
+//
+// The access to the nodes of a b-tree, so that BTree & PagedBTree share the same algorithms for insertion & deletion.
+//
+// N is the type of the nodes, and R is the type of the references to the children stored in a node, e.g., a pointer
+// for BTree and a page id for PagedBTree; a child is only loaded when the algorithms visit it. Loading and allocating
+// a node may fail, e.g., by an I/O error, and the error is returned by the algorithms.
+type bTreeNodes[T any, N any, R any] interface {
+	isLeaf(node N) bool
+	num(node N) int  // the number of keys
+	setNum(node N, n int)
+	keys(node N) []T
+	children(node N) []R
+	ref(node N) R  // the reference to the node
+	load(ref R) (N, error)
+	adopt(node N, index int)  // called after the index th child is moved into the node from another node
+	markDirty(node N)  // called after the node is modified
+	allocate(isLeaf bool) (N, error)
+	free(node N)
+}
+
+// the insertion & deletion algorithms of b-tree; see BTree for details.
+//
+// Every node is loaded before it is modified, so a node is never left half-modified by an error.
+type bTreeAlgorithms[T any, N any, R any] struct {
+	t int
+	compare func(a, b T) int
+	nodes bTreeNodes[T, N, R]
+}
+
+func (a bTreeAlgorithms[T, N, R]) child(node N, index int) (N, error) {
+	return a.nodes.load(a.nodes.children(node)[index])
+}
+
+// finds the proper index for the given key in the current node.Keys.
+func (a bTreeAlgorithms[T, N, R]) findKey(node N, val T) int {
+	keys, n := a.nodes.keys(node), a.nodes.num(node)
+	r := 0
+	for r < n && a.compare(keys[r], val) == -1 {
+		r ++
+	}
+	return r
+}
+
+func (a bTreeAlgorithms[T, N, R]) predecessor(node N) (T, error) {
+	cur := node
+	for !a.nodes.isLeaf(cur) {
+		var err error
+		if cur, err = a.child(cur, a.nodes.num(cur)); err != nil {
+			var zero T
+			return zero, err
+		}
+	}
+	return a.nodes.keys(cur)[a.nodes.num(cur) - 1], nil
+}
+
+func (a bTreeAlgorithms[T, N, R]) successor(node N) (T, error) {
+	cur := node
+	for !a.nodes.isLeaf(cur) {
+		var err error
+		if cur, err = a.child(cur, 0); err != nil {
+			var zero T
+			return zero, err
+		}
+	}
+	return a.nodes.keys(cur)[0], nil
+}
+
+// splits the index th child of the node; index starts from 0.
+func (a bTreeAlgorithms[T, N, R]) splitChild(node N, index int) error {
+	t := a.t
+	y, err := a.child(node, index)
+	if err != nil {
+		return err
+	}
+	z, err := a.nodes.allocate(a.nodes.isLeaf(y))
+	if err != nil {
+		return err
+	}
+	a.nodes.setNum(z, t - 1)
+
+	yKeys, zKeys := a.nodes.keys(y), a.nodes.keys(z)
+	for i := 0; i < t - 1; i ++ {
+		zKeys[i] = yKeys[i + t]
+	}
+	if !a.nodes.isLeaf(z) {
+		yChildren, zChildren := a.nodes.children(y), a.nodes.children(z)
+		for i := 0; i < t; i ++ {
+			zChildren[i] = yChildren[i + t]
+		}
+	}
+
+	a.nodes.setNum(y, t - 1)  // the mid-key (t th key) is popped up so the total number of keys left is 2 * t -2
+
+	n, keys, children := a.nodes.num(node), a.nodes.keys(node), a.nodes.children(node)
+	for i := n; i >= index + 1; i -- {  // note that we assume node itself is not full
+		children[i + 1] = children[i]
+	}
+	children[index + 1] = a.nodes.ref(z)
+	a.nodes.adopt(node, index + 1)
+
+	for i := n - 1; i >= index; i -- {
+		keys[i + 1] = keys[i]
+	}
+	keys[index] = yKeys[t - 1]
+	a.nodes.setNum(node, n + 1)
+
+	a.nodes.markDirty(node)
+	a.nodes.markDirty(y)
+	return nil
+}
+
+// inserts val into the node; the node must not be full
+func (a bTreeAlgorithms[T, N, R]) insertNotFull(node N, val T) error {
+	keys := a.nodes.keys(node)
+	i := a.nodes.num(node) - 1
+	if a.nodes.isLeaf(node) {
+		for i >= 0 && a.compare(keys[i], val) == 1 {
+			keys[i + 1] = keys[i]
+			i --
+		}
+		keys[i + 1] = val
+		a.nodes.setNum(node, a.nodes.num(node) + 1)
+		a.nodes.markDirty(node)
+		return nil
+	}
+
+	for i >= 0 && a.compare(keys[i], val) == 1 {
+		i --
+	}
+	i ++  // to get the child after i th key
+	c, err := a.child(node, i)
+	if err != nil {
+		return err
+	}
+	if a.nodes.num(c) == 2 * a.t - 1 {
+		if err := a.splitChild(node, i); err != nil {
+			return err
+		}
+		if a.compare(keys[i], val) == -1 {  // the popped up mid-key may be smaller than val
+			i ++
+		}
+		if c, err = a.child(node, i); err != nil {
+			return err
+		}
+	}
+	return a.insertNotFull(c, val)
+}
+
+// inserts val into the tree, and returns the new root.
+func (a bTreeAlgorithms[T, N, R]) insert(root N, val T) (N, error) {
+	if a.nodes.num(root) == 2 * a.t - 1 {
+		s, err := a.nodes.allocate(false)
+		if err != nil {
+			return root, err
+		}
+		a.nodes.children(s)[0] = a.nodes.ref(root)
+		a.nodes.adopt(s, 0)
+		if err := a.splitChild(s, 0); err != nil {  // this will also set keys for s
+			return root, err
+		}
+		return s, a.insertNotFull(s, val)
+	}
+	return root, a.insertNotFull(root, val)
+}
+
+// removes the key k from the sub-tree rooted with this node.
+func (a bTreeAlgorithms[T, N, R]) removeFromNode(node N, val T) (bool, error) {
+	idx := a.findKey(node, val)
+	if idx < a.nodes.num(node) && a.compare(a.nodes.keys(node)[idx], val) == 0 {
+		if a.nodes.isLeaf(node) {
+			return a.removeFromLeaf(node, idx), nil
+		} else {
+			return a.removeFromNonLeaf(node, idx)
+		}
+	}
+
+	if a.nodes.isLeaf(node) {  // not found
+		return false, nil
+	}
+
+	flag := idx == a.nodes.num(node)
+
+	c, err := a.child(node, idx)
+	if err != nil {
+		return false, err
+	}
+	if a.nodes.num(c) < a.t {
+		if err := a.fill(node, idx); err != nil {
+			return false, err
+		}
+	}
+
+	if flag && idx > a.nodes.num(node) {
+		c, err = a.child(node, idx - 1)
+	} else {
+		c, err = a.child(node, idx)
+	}
+	if err != nil {
+		return false, err
+	}
+	return a.removeFromNode(c, val)
+}
+
+// removes a key from a leaf node.
+func (a bTreeAlgorithms[T, N, R]) removeFromLeaf(node N, index int) bool {
+	keys, n := a.nodes.keys(node), a.nodes.num(node)
+	for i := index + 1; i < n; i ++ {
+		keys[i - 1] = keys[i]
+	}
+	a.nodes.setNum(node, n - 1)
+	a.nodes.markDirty(node)
+	return true
+}
+
+// removes a key from a non-leaf node
+func (a bTreeAlgorithms[T, N, R]) removeFromNonLeaf(node N, index int) (bool, error) {
+	keys := a.nodes.keys(node)
+	k := keys[index]
+
+	left, err := a.child(node, index)
+	if err != nil {
+		return false, err
+	}
+	if a.nodes.num(left) >= a.t {
+		predecessor, err := a.predecessor(left)
+		if err != nil {
+			return false, err
+		}
+		keys[index] = predecessor
+		a.nodes.markDirty(node)
+		return a.removeFromNode(left, predecessor)
+	}
+
+	right, err := a.child(node, index + 1)
+	if err != nil {
+		return false, err
+	}
+	if a.nodes.num(right) >= a.t {
+		successor, err := a.successor(right)
+		if err != nil {
+			return false, err
+		}
+		keys[index] = successor
+		a.nodes.markDirty(node)
+		return a.removeFromNode(right, successor)
+	}
+
+	if err := a.combineChildren(node, index, index + 1); err != nil {
+		return false, err
+	}
+	return a.removeFromNode(left, k)  // left is the combined child
+}
+
+// combines 2 neighboring children of the node; the second child is freed.
+func (a bTreeAlgorithms[T, N, R]) combineChildren(node N, index1 int, index2 int) error {
+	t := a.t
+	y1, err := a.child(node, index1)
+	if err != nil {
+		return err
+	}
+	y2, err := a.child(node, index2)
+	if err != nil {
+		return err
+	}
+	y1Keys, y2Keys, y2N := a.nodes.keys(y1), a.nodes.keys(y2), a.nodes.num(y2)
+	n, keys, children := a.nodes.num(node), a.nodes.keys(node), a.nodes.children(node)
+
+	y1Keys[t - 1] = keys[index1]  // add "k" to y1.Keys
+
+	for i := 0; i < y2N; i ++ {  // copying keys
+		y1Keys[i + t] = y2Keys[i]
+	}
+
+	if !a.nodes.isLeaf(y1) {
+		y1Children, y2Children := a.nodes.children(y1), a.nodes.children(y2)
+		for i := 0; i <= y2N; i ++ {  // copying children; y2 has y2.N + 1 children
+			y1Children[i + t] = y2Children[i]
+			a.nodes.adopt(y1, i + t)
+		}
+	}
+
+	for i := index2; i < n; i ++ {  // move keys
+		keys[i - 1] = keys[i]  // node.Keys[index] is deleted here
+	}
+
+	for i := index2 + 1; i < n + 1; i ++ {  // move children
+		children[i - 1] = children[i]  // node.Children[index2] is deleted here
+	}
+
+	a.nodes.setNum(y1, a.nodes.num(y1) + y2N + 1)
+	a.nodes.setNum(node, n - 1)
+
+	a.nodes.markDirty(node)
+	a.nodes.markDirty(y1)
+	a.nodes.free(y2)
+	return nil
+}
+
+// borrows a key from the previous / left sibling.
+func (a bTreeAlgorithms[T, N, R]) borrowPrev(node N, index int) error {
+	cur, err := a.child(node, index)
+	if err != nil {
+		return err
+	}
+	toBorrow, err := a.child(node, index - 1)
+	if err != nil {
+		return err
+	}
+	keys, curKeys, curN := a.nodes.keys(node), a.nodes.keys(cur), a.nodes.num(cur)
+	borrowKeys, borrowN := a.nodes.keys(toBorrow), a.nodes.num(toBorrow)
+
+	// the borrowed key will be smaller; so move all keys in cur on step to the right
+	for i := curN - 1; i >= 0; i -- {
+		curKeys[i + 1] = curKeys[i]
+	}
+
+	// also, moves all children if necessary
+	if !a.nodes.isLeaf(cur) {
+		curChildren := a.nodes.children(cur)
+		for i := curN; i >= 0; i -- {
+			curChildren[i + 1] = curChildren[i]
+		}
+	}
+
+	curKeys[0] = keys[index - 1]  // use the previous key in the node as the first key of the child
+
+	if !a.nodes.isLeaf(cur) {  // do not forget the child of the sibling
+		a.nodes.children(cur)[0] = a.nodes.children(toBorrow)[borrowN]
+		a.nodes.adopt(cur, 0)
+	}
+
+	keys[index - 1] = borrowKeys[borrowN - 1]  // use the last key in toBorrow as the new key
+	a.nodes.setNum(cur, curN + 1)
+	a.nodes.setNum(toBorrow, borrowN - 1)
+
+	a.nodes.markDirty(node)
+	a.nodes.markDirty(cur)
+	a.nodes.markDirty(toBorrow)
+	return nil
+}
+
+// borrows a key from the next / right sibling.
+func (a bTreeAlgorithms[T, N, R]) borrowNext(node N, index int) error {
+	cur, err := a.child(node, index)
+	if err != nil {
+		return err
+	}
+	toBorrow, err := a.child(node, index + 1)
+	if err != nil {
+		return err
+	}
+	keys, curKeys, curN := a.nodes.keys(node), a.nodes.keys(cur), a.nodes.num(cur)
+	borrowKeys, borrowN := a.nodes.keys(toBorrow), a.nodes.num(toBorrow)
+
+	// the borrowed key will be bigger
+	curKeys[curN] = keys[index]
+	if !a.nodes.isLeaf(cur) {
+		a.nodes.children(cur)[curN + 1] = a.nodes.children(toBorrow)[0]
+		a.nodes.adopt(cur, curN + 1)
+	}
+
+	keys[index] = borrowKeys[0]
+
+	// updates toBorrow
+	for i := 0; i < borrowN - 1; i ++ {
+		borrowKeys[i] = borrowKeys[i + 1]
+	}
+
+	if !a.nodes.isLeaf(toBorrow) {
+		borrowChildren := a.nodes.children(toBorrow)
+		for i := 0; i < borrowN; i ++ {
+			borrowChildren[i] = borrowChildren[i + 1]
+		}
+	}
+
+	a.nodes.setNum(cur, curN + 1)
+	a.nodes.setNum(toBorrow, borrowN - 1)
+
+	a.nodes.markDirty(node)
+	a.nodes.markDirty(cur)
+	a.nodes.markDirty(toBorrow)
+	return nil
+}
+
+// fills the child node which has less than t - 1 keys.
+func (a bTreeAlgorithms[T, N, R]) fill(node N, index int) error {
+	// the main idea is to decide where to borrow
+	t := a.t
+	n := a.nodes.num(node)
+	if index != 0 {
+		prev, err := a.child(node, index - 1)
+		if err != nil {
+			return err
+		}
+		if a.nodes.num(prev) >= t {
+			return a.borrowPrev(node, index)
+		}
+	}
+	if index != n {
+		next, err := a.child(node, index + 1)
+		if err != nil {
+			return err
+		}
+		if a.nodes.num(next) >= t {
+			return a.borrowNext(node, index)
+		}
+	}
+	// none of the siblings have extra keys
+	if index != n {
+		return a.combineChildren(node, index, index + 1)
+	}
+	return a.combineChildren(node, index - 1, index)
+}
+
+// deletes the first corresponding value from the tree, and returns the new root & a boolean indicating whether
+// the deletion is successful.
+func (a bTreeAlgorithms[T, N, R]) delete(root N, val T) (N, bool, error) {
+	b, err := a.removeFromNode(root, val)
+	if err != nil {
+		return root, false, err
+	}
+
+	// check if the root has no keys && no children
+	if a.nodes.num(root) == 0 && !a.nodes.isLeaf(root) {
+		newRoot, err := a.child(root, 0)
+		if err != nil {
+			return root, false, err
+		}
+		a.nodes.free(root)
+		return newRoot, b, nil
+	}
+	return root, b, nil
+}
@@ -0,0 +1,169 @@
+package generics
+
+import (
+	"bytes"
+	"io"
+	"math"
+)
+
+// the biggest minimum degree accepted by ReadFrom; as every node holds 2*t - 1 keys, a corrupted t must not make
+// it allocate huge nodes.
+const maxSerializedDegree int = 1 << 16
+
+// SetCodec sets the codec for encoding and decoding the values in WriteTo, ReadFrom, MarshalBinary and UnmarshalBinary.
+func (bt *BTree[T]) SetCodec(codec Codec[T]) {
+	bt.codec = codec
+}
+
+// WriteTo writes the tree to w node by node, so that it can be loaded back by ReadFrom without re-inserting the values.
+//
+// The layout is: t, the number of elements, then every node in pre-order as IsLeaf, N, the N keys (encoded by
+// the codec) and, for an internal node, its N+1 children. t, N and the number of elements are encoded as uvarint.
+//
+// Returns the number of bytes written; it implements io.WriterTo.
+func (bt *BTree[T]) WriteTo(w io.Writer) (int64, error) {
+	if bt.codec == nil {
+		return 0, ErrNoCodec
+	}
+	cw := &countingWriter{w: w}
+	if err := cw.writeUvarint(uint64(bt.t)); err != nil {
+		return cw.n, err
+	}
+	if err := cw.writeUvarint(uint64(bt.num)); err != nil {
+		return cw.n, err
+	}
+	var writeNode func(node *BTreeNode[T]) error
+	writeNode = func(node *BTreeNode[T]) error {
+		isLeaf := byte(0)
+		if node.IsLeaf {
+			isLeaf = 1
+		}
+		if _, err := cw.Write([]byte{isLeaf}); err != nil {
+			return err
+		}
+		if err := cw.writeUvarint(uint64(node.N)); err != nil {
+			return err
+		}
+		for i := 0; i < node.N; i ++ {
+			if err := bt.codec.Encode(cw, node.Keys[i]); err != nil {
+				return err
+			}
+		}
+		if !node.IsLeaf {
+			for i := 0; i <= node.N; i ++ {
+				if err := writeNode(node.Children[i]); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	err := writeNode(bt.Root)
+	return cw.n, err
+}
+
+// ReadFrom replaces the tree with the one read from r, which must be written by WriteTo with the same kind of codec.
+//
+// The minimum degree t is also read from r. The tree is unchanged if an error occurs; ErrCorruptedData is returned
+// if the data does not form a valid B-tree, or t is bigger than 65536.
+//
+// Returns the number of bytes read; it implements io.ReaderFrom.
+func (bt *BTree[T]) ReadFrom(r io.Reader) (int64, error) {
+	if bt.codec == nil {
+		return 0, ErrNoCodec
+	}
+	cr := &countingReader{r: r}
+	t, err := cr.readUvarint()
+	if err != nil {
+		return cr.n, err
+	}
+	num, err := cr.readUvarint()
+	if err != nil {
+		return cr.n, err
+	}
+	if t < 2 || t > uint64(maxSerializedDegree) || num > math.MaxInt32 {
+		return cr.n, ErrCorruptedData
+	}
+	minDegree := int(t)
+
+	count := 0
+	leafDepth := -1
+	var readNode func(parent *BTreeNode[T], depth int) (*BTreeNode[T], error)
+	readNode = func(parent *BTreeNode[T], depth int) (*BTreeNode[T], error) {
+		isLeaf, err := cr.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		n, err := cr.readUvarint()
+		if err != nil {
+			return nil, err
+		}
+		if isLeaf > 1 || n > uint64(2 * minDegree - 1) || (parent != nil && n < uint64(minDegree - 1)) ||
+			(parent == nil && n == 0 && (isLeaf == 0 || num > 0)) {
+			return nil, ErrCorruptedData
+		}
+		keys := make([]T, n)  // the keys are decoded before allocating the node, so a node is only as big as the input
+		for i := range keys {
+			if keys[i], err = bt.codec.Decode(cr); err != nil {
+				return nil, err
+			}
+		}
+		node := NewBTreeNode[T](minDegree, isLeaf == 1)
+		node.N = int(n)
+		node.Parent = parent
+		copy(node.Keys, keys)
+		count += node.N
+		if node.IsLeaf {  // all the leaves must have the same depth
+			if leafDepth == -1 {
+				leafDepth = depth
+			} else if leafDepth != depth {
+				return nil, ErrCorruptedData
+			}
+			return node, nil
+		}
+		for i := 0; i <= node.N; i ++ {
+			if node.Children[i], err = readNode(node, depth + 1); err != nil {
+				return nil, err
+			}
+		}
+		return node, nil
+	}
+	root, err := readNode(nil, 0)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return cr.n, err
+	}
+	if count != int(num) {
+		return cr.n, ErrCorruptedData
+	}
+
+	bt.t, bt.num, bt.Root = minDegree, count, root
+	return cr.n, nil
+}
+
+// MarshalBinary encodes the tree into bytes; see WriteTo for details.
+//
+// It implements encoding.BinaryMarshaler.
+func (bt *BTree[T]) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	if _, err := bt.WriteTo(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary replaces the tree with the one decoded from data; see ReadFrom for details.
+//
+// It implements encoding.BinaryUnmarshaler.
+func (bt *BTree[T]) UnmarshalBinary(data []byte) error {
+	r := bytes.NewReader(data)
+	if _, err := bt.ReadFrom(r); err != nil {
+		return err
+	}
+	if r.Len() != 0 {
+		return ErrCorruptedData
+	}
+	return nil
+}
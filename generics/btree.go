@@ -0,0 +1,412 @@
+package generics
+
+import (
+	"fmt"
+)
+
+// BTree
+//
+// The B-tree structure.
+//
+// Attributes:
+//
+//		t int
+//
+//		num int
+//
+//		Root *BTreeNode[T]
+//
+//		compare func(a, b T) int
+//
+// .
+//
+// t int
+//
+//		The minimum degree.
+//		Every node except root must contain at least t-1 keys. The root may contain minimum 1 key.
+//		All nodes (including root) may contain at most 2*t – 1 keys.
+//
+// .
+//
+// compare is the function for comparing different node values;
+// it should return 1 if a > b , 0 if a == b, -1 if a < b
+//
+// .
+//
+// Both inputs of the compare function are of type T. When T is interface{}, the second input may have variant types,
+// and a tricky compare method can relax the conditions for Search and Delete; see examples for details.
+//
+// .
+//
+// Note that this BTree does not perform type checking; please include any necessary type checking
+// in the customized compare function
+type BTree[T any] struct {
+	t int
+	num int  // track number of elements in the tree
+	Root *BTreeNode[T]
+	compare func(a, b T) int
+	codec Codec[T]  // for serialization; see SetCodec
+}
+
+// T returns the minimum degree
+func (bt *BTree[T]) T() int {
+	return bt.t
+}
+
+// NumOfElements returns the number of elements in the BTree
+func (bt *BTree[T]) NumOfElements() int {
+	return bt.num
+}
+
+// Search searches for an element in the BTree.
+//
+// Returns the first corresponding node, the index of the corresponding value on the node,
+// and a boolean indicating whether the searching is successful.
+func (bt *BTree[T]) Search(val T)  (*BTreeNode[T], int, bool) {
+	cur := bt.Root
+
+	for cur != nil {
+		i := 0
+		for i < cur.N && bt.compare(cur.Keys[i], val) == -1 {
+			i ++
+		}
+
+		if i < cur.N && bt.compare(cur.Keys[i], val) == 0 {
+			return cur, i, true
+		} else if cur.IsLeaf {
+			return nil, -1, false
+		} else {
+			cur = cur.Children[i]  // here, "i" can be equal to cur.n, which means the last child
+		}
+	}
+
+	return nil, -1, false
+}
+
+func (bt *BTree[T]) Predecessor(node *BTreeNode[T]) T {
+	cur := node
+	for !cur.IsLeaf {
+		cur = cur.Children[cur.N]
+	}
+	return cur.Keys[cur.N - 1]
+}
+
+func (bt *BTree[T]) Successor(node *BTreeNode[T]) T {
+	cur := node
+	for !cur.IsLeaf {
+		cur = cur.Children[0]
+	}
+	return cur.Keys[0]
+}
+
+// returns the smallest value that is bigger than val (strict) or bigger than or equal to val (not strict).
+func (bt *BTree[T]) ceiling(val T, strict bool) (T, bool) {
+	var r T
+	found := false
+	cur := bt.Root
+	for cur != nil {
+		i := 0  // the index of the first candidate in cur.Keys
+		for i < cur.N {
+			c := bt.compare(cur.Keys[i], val)
+			if c == 1 || (c == 0 && !strict) {
+				break
+			}
+			i ++
+		}
+		if i < cur.N {
+			r = cur.Keys[i]
+			found = true
+		}
+		if cur.IsLeaf {
+			break
+		}
+		cur = cur.Children[i]  // smaller candidates can only be in the child before the i th key
+	}
+	return r, found
+}
+
+// returns the biggest value that is smaller than val (strict) or smaller than or equal to val (not strict).
+func (bt *BTree[T]) floor(val T, strict bool) (T, bool) {
+	var r T
+	found := false
+	cur := bt.Root
+	for cur != nil {
+		i := 0  // the number of candidates in cur.Keys
+		for i < cur.N {
+			c := bt.compare(cur.Keys[i], val)
+			if c == 1 || (c == 0 && strict) {
+				break
+			}
+			i ++
+		}
+		if i > 0 {
+			r = cur.Keys[i - 1]
+			found = true
+		}
+		if cur.IsLeaf {
+			break
+		}
+		cur = cur.Children[i]  // bigger candidates can only be in the child after the (i - 1) th key
+	}
+	return r, found
+}
+
+// Ceiling returns the smallest value that is bigger than or equal to val.
+func (bt *BTree[T]) Ceiling(val T) (T, bool) {
+	return bt.ceiling(val, false)
+}
+
+// Higher returns the smallest value that is strictly bigger than val.
+func (bt *BTree[T]) Higher(val T) (T, bool) {
+	return bt.ceiling(val, true)
+}
+
+// Floor returns the biggest value that is smaller than or equal to val.
+func (bt *BTree[T]) Floor(val T) (T, bool) {
+	return bt.floor(val, false)
+}
+
+// Lower returns the biggest value that is strictly smaller than val.
+func (bt *BTree[T]) Lower(val T) (T, bool) {
+	return bt.floor(val, true)
+}
+
+// Range returns all the values between lo and hi in an ascending order.
+//
+// inclusiveLo and inclusiveHi decide whether the values equal to lo and hi are included.
+//
+// It only visits the subtrees that may contain such values, so it costs O(log n + k).
+func (bt *BTree[T]) Range(lo, hi T, inclusiveLo, inclusiveHi bool) []T {
+	r := make([]T, 0)
+	aboveLo := func(v T) bool {
+		c := bt.compare(v, lo)
+		return c == 1 || (c == 0 && inclusiveLo)
+	}
+	belowHi := func(v T) bool {
+		c := bt.compare(v, hi)
+		return c == -1 || (c == 0 && inclusiveHi)
+	}
+	// returns false once a value above hi is found
+	var dfs func(node *BTreeNode[T]) bool
+	dfs = func(node *BTreeNode[T]) bool {
+		for i := 0; i < node.N; i ++ {
+			above := aboveLo(node.Keys[i])
+			if !node.IsLeaf && above {  // the i th child only has values smaller than or equal to the i th key
+				if !dfs(node.Children[i]) {
+					return false
+				}
+			}
+			if !belowHi(node.Keys[i]) {
+				return false
+			}
+			if above {
+				r = append(r, node.Keys[i])
+			}
+		}
+		if !node.IsLeaf {
+			return dfs(node.Children[node.N])
+		}
+		return true
+	}
+	dfs(bt.Root)
+	return r
+}
+
+// the nodes of BTree, which are kept in memory; see bTreeNodes.
+type bTreeMemory[T any] struct {
+	t int
+}
+
+func (bTreeMemory[T]) isLeaf(node *BTreeNode[T]) bool {
+	return node.IsLeaf
+}
+
+func (bTreeMemory[T]) num(node *BTreeNode[T]) int {
+	return node.N
+}
+
+func (bTreeMemory[T]) setNum(node *BTreeNode[T], n int) {
+	node.N = n
+}
+
+func (bTreeMemory[T]) keys(node *BTreeNode[T]) []T {
+	return node.Keys
+}
+
+func (bTreeMemory[T]) children(node *BTreeNode[T]) []*BTreeNode[T] {
+	return node.Children
+}
+
+func (bTreeMemory[T]) ref(node *BTreeNode[T]) *BTreeNode[T] {
+	return node
+}
+
+func (bTreeMemory[T]) load(ref *BTreeNode[T]) (*BTreeNode[T], error) {
+	return ref, nil
+}
+
+func (bTreeMemory[T]) adopt(node *BTreeNode[T], index int) {
+	node.Children[index].Parent = node
+}
+
+func (bTreeMemory[T]) markDirty(*BTreeNode[T]) {}
+
+func (m bTreeMemory[T]) allocate(isLeaf bool) (*BTreeNode[T], error) {
+	return NewBTreeNode[T](m.t, isLeaf), nil
+}
+
+func (bTreeMemory[T]) free(*BTreeNode[T]) {}
+
+func (bt *BTree[T]) algorithms() bTreeAlgorithms[T, *BTreeNode[T], *BTreeNode[T]] {
+	return bTreeAlgorithms[T, *BTreeNode[T], *BTreeNode[T]]{t: bt.t, compare: bt.compare, nodes: bTreeMemory[T]{t: bt.t}}
+}
+
+// Insert inserts a new value into the b-tree.
+func (bt *BTree[T]) Insert(val T) {
+	bt.Root, _ = bt.algorithms().insert(bt.Root, val)  // the nodes in memory never fail to load
+	bt.num ++
+}
+
+func (bt *BTree[T]) Delete(val T) bool {
+	var b bool
+	bt.Root, b, _ = bt.algorithms().delete(bt.Root, val)
+	if b {
+		bt.num --
+	}
+	bt.Root.Parent = nil
+	return b
+}
+
+// Len returns the number of elements in the tree; same as NumOfElements.
+func (bt *BTree[T]) Len() int {
+	return bt.num
+}
+
+// IsEmpty returns true if the tree has no elements.
+func (bt *BTree[T]) IsEmpty() bool {
+	return bt.num == 0
+}
+
+// Contains returns true if the val exists in the tree.
+func (bt *BTree[T]) Contains(val T) bool {
+	_, _, b := bt.Search(val)
+	return b
+}
+
+// Values returns all the values in the tree in an ordered manner.
+//
+// Note: it uses (modified) dfs and is expensive.
+func (bt *BTree[T]) Values() []T {
+	r := make([]T, bt.num)
+	index := 0
+	var dfs func(node *BTreeNode[T])
+	dfs = func(node *BTreeNode[T]) {
+		if node == nil {
+			return
+		}
+		for i := 0; i < node.N; i ++ {
+			if !node.IsLeaf {
+				dfs(node.Children[i])
+			}
+			r[index] = node.Keys[i]
+			index ++
+		}
+		dfs(node.Children[node.N])
+	}
+	dfs(bt.Root)
+	return r
+}
+
+// NewBTree returns a NewBtree object
+//
+// t must > 1; otherwise it will return nil.
+func NewBTree[T any](t int, compare func(a, b T) int) *BTree[T] {
+	if t < 2 {
+		fmt.Println("the minimum degree t must be > 1")
+		return nil
+	}
+	return &BTree[T]{Root: NewBTreeNode[T](t, true), t: t, compare: compare}
+}
+
+// Iterator returns a cursor walking through the values in an ascending order.
+//
+// The values are visited lazily; modifying the tree invalidates the cursor.
+func (bt *BTree[T]) Iterator() *BTreeIterator[T] {
+	it := &BTreeIterator[T]{}
+	it.pushLeft(bt.Root)
+	return it
+}
+
+// ReverseIterator returns a cursor walking through the values in a descending order.
+//
+// The values are visited lazily; modifying the tree invalidates the cursor.
+func (bt *BTree[T]) ReverseIterator() *BTreeIterator[T] {
+	it := &BTreeIterator[T]{reverse: true}
+	it.pushRight(bt.Root)
+	return it
+}
+
+// the position of a BTreeIterator on a node.
+type btreeFrame[T any] struct {
+	node *BTreeNode[T]
+	index int  // the index of the next key to visit on the node
+}
+
+// BTreeIterator
+//
+// The cursor over a BTree. Please use BTree.Iterator() or BTree.ReverseIterator() to create one.
+//
+// It keeps the path from the root to the current node, so it takes O(log n) memory.
+type BTreeIterator[T any] struct {
+	path []btreeFrame[T]
+	reverse bool
+}
+
+// pushes the node and its leftmost descendants onto the path.
+func (it *BTreeIterator[T]) pushLeft(node *BTreeNode[T]) {
+	for node != nil {
+		it.path = append(it.path, btreeFrame[T]{node: node, index: 0})
+		if node.IsLeaf {
+			break
+		}
+		node = node.Children[0]
+	}
+}
+
+// pushes the node and its rightmost descendants onto the path.
+func (it *BTreeIterator[T]) pushRight(node *BTreeNode[T]) {
+	for node != nil {
+		it.path = append(it.path, btreeFrame[T]{node: node, index: node.N - 1})
+		if node.IsLeaf {
+			break
+		}
+		node = node.Children[node.N]
+	}
+}
+
+// Next returns the next value and true, or the zero value of T and false if there are no more values.
+func (it *BTreeIterator[T]) Next() (T, bool) {
+	for len(it.path) > 0 {
+		top := &it.path[len(it.path) - 1]
+		if top.index < 0 || top.index >= top.node.N {  // all the keys on this node are visited
+			it.path = it.path[:len(it.path) - 1]
+			continue
+		}
+		node, i := top.node, top.index
+		v := node.Keys[i]
+		if it.reverse {
+			top.index --
+			if !node.IsLeaf {
+				it.pushRight(node.Children[i])  // the values right before the i th key
+			}
+		} else {
+			top.index ++
+			if !node.IsLeaf {
+				it.pushLeft(node.Children[i + 1])  // the values right after the i th key
+			}
+		}
+		return v, true
+	}
+	var zero T
+	return zero, false
+}
@@ -0,0 +1,148 @@
+package generics
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+)
+
+// ErrNoCodec is returned when a structure is serialized without a codec.
+var ErrNoCodec = errors.New("no codec for encoding the values")
+
+// ErrCorruptedData is returned when the serialized data is not valid.
+var ErrCorruptedData = errors.New("corrupted data")
+
+// Codec
+//
+// The interface for encoding and decoding the values of a structure during serialization.
+//
+// Decode must read exactly the bytes written by Encode.
+type Codec[T any] interface {
+	Encode(w io.Writer, val T) error
+	Decode(r io.Reader) (T, error)
+}
+
+// IntCodec encodes an int as 8 bytes in big-endian.
+type IntCodec struct{}
+
+func (IntCodec) Encode(w io.Writer, val int) error {
+	return binary.Write(w, binary.BigEndian, int64(val))
+}
+
+func (IntCodec) Decode(r io.Reader) (int, error) {
+	var val int64
+	err := binary.Read(r, binary.BigEndian, &val)
+	return int(val), err
+}
+
+// Float64Codec encodes a float64 as 8 bytes in big-endian.
+type Float64Codec struct{}
+
+func (Float64Codec) Encode(w io.Writer, val float64) error {
+	return binary.Write(w, binary.BigEndian, val)
+}
+
+func (Float64Codec) Decode(r io.Reader) (float64, error) {
+	var val float64
+	err := binary.Read(r, binary.BigEndian, &val)
+	return val, err
+}
+
+// StringCodec encodes a string as its length (8 bytes in big-endian) followed by its bytes.
+type StringCodec struct{}
+
+func (StringCodec) Encode(w io.Writer, val string) error {
+	if err := binary.Write(w, binary.BigEndian, uint64(len(val))); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, val)
+	return err
+}
+
+func (StringCodec) Decode(r io.Reader) (string, error) {
+	var n uint64
+	if err := binary.Read(r, binary.BigEndian, &n); err != nil {
+		return "", err
+	}
+	if n > math.MaxInt64 {
+		return "", ErrCorruptedData
+	}
+	// reads through a limited reader, so that a corrupted length does not allocate a huge buffer at once
+	buf, err := io.ReadAll(io.LimitReader(r, int64(n)))
+	if err != nil {
+		return "", err
+	}
+	if uint64(len(buf)) != n {
+		return "", io.ErrUnexpectedEOF
+	}
+	return string(buf), nil
+}
+
+// interfaceCodec wraps a Codec[T] for the structures storing interface{} values.
+type interfaceCodec[T any] struct {
+	codec Codec[T]
+}
+
+func (ic interfaceCodec[T]) Encode(w io.Writer, val interface{}) error {
+	return ic.codec.Encode(w, val.(T))
+}
+
+func (ic interfaceCodec[T]) Decode(r io.Reader) (interface{}, error) {
+	return ic.codec.Decode(r)
+}
+
+// InterfaceCodec returns a Codec[interface{}] which encodes the values of type T with the given codec.
+//
+// e.g., InterfaceCodec[int](IntCodec{}) works for a BTree[interface{}] storing int values.
+func InterfaceCodec[T any](codec Codec[T]) Codec[interface{}] {
+	return interfaceCodec[T]{codec: codec}
+}
+
+// the writer counting the number of bytes written, as required by io.WriterTo.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	return n, err
+}
+
+func (cw *countingWriter) writeUvarint(x uint64) error {
+	buf := make([]byte, binary.MaxVarintLen64)
+	_, err := cw.Write(buf[:binary.PutUvarint(buf, x)])
+	return err
+}
+
+// the reader counting the number of bytes read, as required by io.ReaderFrom.
+//
+// It never reads ahead, so that the bytes after the structure remain in the underlying reader.
+type countingReader struct {
+	r io.Reader
+	n int64
+	buf [1]byte
+}
+
+func (cr *countingReader) Read(p []byte) (int, error) {
+	n, err := cr.r.Read(p)
+	cr.n += int64(n)
+	return n, err
+}
+
+func (cr *countingReader) ReadByte() (byte, error) {
+	if _, err := io.ReadFull(cr, cr.buf[:]); err != nil {
+		return 0, err
+	}
+	return cr.buf[0], nil
+}
+
+func (cr *countingReader) readUvarint() (uint64, error) {
+	x, err := binary.ReadUvarint(cr)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return x, err
+}
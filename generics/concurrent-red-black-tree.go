@@ -0,0 +1,112 @@
+package generics
+
+import (
+	"some-data-structures/common"
+	"sync"
+)
+
+// ConcurrentRedBlackTree
+//
+// The red-black tree which is safe for concurrent readers and writers. Please use NewConcurrentRedBlackTree() as
+// the safe constructor.
+//
+// All the methods are protected by a sync.RWMutex: the writers (Insert, UnsafeInsert and Delete) hold the lock
+// exclusively, and the readers share it.
+//
+// Different from RedBlackTree, the methods return values instead of the pointers to the tree nodes, as the nodes
+// may be changed by other goroutines once the lock is released.
+type ConcurrentRedBlackTree[T any] struct {
+	mu sync.RWMutex
+	tree *RedBlackTree[T]
+}
+
+// Insert inserts a new val as a new node.
+//
+// Does not insert if the val already exists in the tree.
+func (crbt *ConcurrentRedBlackTree[T]) Insert(val T) bool {
+	crbt.mu.Lock()
+	defer crbt.mu.Unlock()
+	return crbt.tree.Insert(val)
+}
+
+// UnsafeInsert inserts a new val as a new node and allows the same val to be inserted for multiple times.
+func (crbt *ConcurrentRedBlackTree[T]) UnsafeInsert(val T) {
+	crbt.mu.Lock()
+	defer crbt.mu.Unlock()
+	crbt.tree.UnsafeInsert(val)
+}
+
+// Delete deletes the First node with the corresponding value if it exists.
+//
+// it returns a boolean value indicating if the deletion is successful.
+func (crbt *ConcurrentRedBlackTree[T]) Delete(val T) bool {
+	crbt.mu.Lock()
+	defer crbt.mu.Unlock()
+	return crbt.tree.Delete(val)
+}
+
+// Search returns the value of the FIRST corresponding node if that node exists in the tree.
+func (crbt *ConcurrentRedBlackTree[T]) Search(val T) (T, bool) {
+	crbt.mu.RLock()
+	defer crbt.mu.RUnlock()
+	node, b := crbt.tree.Search(val)
+	if !b {
+		var zero T
+		return zero, false
+	}
+	return node.Val, true
+}
+
+// Size returns the number of nodes in the tree.
+func (crbt *ConcurrentRedBlackTree[T]) Size() int {
+	crbt.mu.RLock()
+	defer crbt.mu.RUnlock()
+	return crbt.tree.Size()
+}
+
+// Len returns the number of nodes in the tree; same as Size.
+func (crbt *ConcurrentRedBlackTree[T]) Len() int {
+	return crbt.Size()
+}
+
+// IsEmpty returns true if the tree has no nodes.
+func (crbt *ConcurrentRedBlackTree[T]) IsEmpty() bool {
+	return crbt.Size() == 0
+}
+
+// Values returns all the values of the tree in ascending order; same as InOrderTreeWalk.
+func (crbt *ConcurrentRedBlackTree[T]) Values() []T {
+	return crbt.InOrderTreeWalk()
+}
+
+// Contains returns true if the val exists in the tree.
+func (crbt *ConcurrentRedBlackTree[T]) Contains(val T) bool {
+	_, b := crbt.Search(val)
+	return b
+}
+
+// InOrderTreeWalk returns all the values of the tree in an in-order-tree-walk manner.
+func (crbt *ConcurrentRedBlackTree[T]) InOrderTreeWalk() []T {
+	crbt.mu.RLock()
+	defer crbt.mu.RUnlock()
+	return crbt.tree.InOrderTreeWalk()
+}
+
+// Range returns all the values between lo and hi in an ascending order; see RedBlackTree.Range for details.
+func (crbt *ConcurrentRedBlackTree[T]) Range(lo, hi T, inclusiveLo, inclusiveHi bool) []T {
+	crbt.mu.RLock()
+	defer crbt.mu.RUnlock()
+	return crbt.tree.Range(lo, hi, inclusiveLo, inclusiveHi)
+}
+
+// Snapshot returns an iterator over the values of the tree at the moment of the call, in an ascending order.
+//
+// The values are copied under the read lock, so the iterator is not affected or invalidated by any later writes.
+func (crbt *ConcurrentRedBlackTree[T]) Snapshot() *common.SliceIterator[T] {
+	return common.NewSliceIterator(crbt.InOrderTreeWalk())
+}
+
+// NewConcurrentRedBlackTree returns a new ConcurrentRedBlackTree object.
+func NewConcurrentRedBlackTree[T any](compare func(a, b T) int) *ConcurrentRedBlackTree[T] {
+	return &ConcurrentRedBlackTree[T]{tree: NewRedBlackTree(compare)}
+}
@@ -0,0 +1,7 @@
+package generics
+
+const defaultSize int = 50
+const errorNoElement string = "no more element"
+const errorKeyValue string = "the value of the key fails the compare condition"
+const errorInvalidIndex string = "invalid index"
+const minDequeSize int = 16  // the minimum size of the ring buffer of Deque; must be a power of 2
@@ -0,0 +1,180 @@
+package generics
+
+import (
+	"errors"
+	"some-data-structures/common"
+)
+
+// the adapter making BTree a common.OrderedSetOf, which rejects duplicated values.
+type btreeSet[T any] struct {
+	*BTree[T]
+}
+
+func (bs btreeSet[T]) Insert(val T) bool {
+	if bs.Contains(val) {
+		return false
+	}
+	bs.BTree.Insert(val)
+	return true
+}
+
+// AsOrderedSet returns a view of the tree as a common.OrderedSetOf; changes through the view are made to the tree.
+//
+// Different from BTree.Insert, the Insert of the view does not insert a value which already exists.
+func (bt *BTree[T]) AsOrderedSet() common.OrderedSetOf[T] {
+	return btreeSet[T]{bt}
+}
+
+// the adapter making PriorityQ a common.PriorityQueueOf.
+type priorityQQueue[T any] struct {
+	*PriorityQ[T]
+}
+
+func (pqq priorityQQueue[T]) Push(v T) {
+	pqq.PriorityQ.Push(v)
+}
+
+func (pqq priorityQQueue[T]) Peek() (T, error) {
+	if !pqq.HasNext() {
+		var zero T
+		return zero, errors.New(errorNoElement)
+	}
+	return pqq.PriorityQ.Peek(), nil
+}
+
+func (pqq priorityQQueue[T]) Pop() (T, error) {
+	if !pqq.HasNext() {
+		var zero T
+		return zero, errors.New(errorNoElement)
+	}
+	return pqq.PriorityQ.Pop(), nil
+}
+
+// AsPriorityQueue returns a view of the queue as a common.PriorityQueueOf; changes through the view are made to
+// the queue.
+func (pq *PriorityQ[T]) AsPriorityQueue() common.PriorityQueueOf[T] {
+	return priorityQQueue[T]{pq}
+}
+
+// the adapter making BinaryHeap a common.PriorityQueueOf.
+type binaryHeapQueue[T any] struct {
+	*BinaryHeap[T]
+}
+
+func (bhq binaryHeapQueue[T]) Push(v T) {
+	_ = bhq.Insert(v)
+}
+
+func (bhq binaryHeapQueue[T]) Peek() (T, error) {
+	return bhq.HeapMaximum()
+}
+
+func (bhq binaryHeapQueue[T]) Pop() (T, error) {
+	return bhq.ExtractHeapMaximum()
+}
+
+// AsPriorityQueue returns a view of the heap as a common.PriorityQueueOf, which pops the maximum first; changes
+// through the view are made to the heap.
+func (bh *BinaryHeap[T]) AsPriorityQueue() common.PriorityQueueOf[T] {
+	return binaryHeapQueue[T]{bh}
+}
+
+// the adapter making IndexedBinaryHeap a common.PriorityQueueOf.
+type indexedBinaryHeapQueue[T any] struct {
+	*IndexedBinaryHeap[T]
+}
+
+func (ibhq indexedBinaryHeapQueue[T]) Push(v T) {
+	ibhq.Insert(v)
+}
+
+func (ibhq indexedBinaryHeapQueue[T]) Peek() (T, error) {
+	item, err := ibhq.HeapMaximum()
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return item.Val, nil
+}
+
+func (ibhq indexedBinaryHeapQueue[T]) Pop() (T, error) {
+	item, err := ibhq.ExtractHeapMaximum()
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return item.Val, nil
+}
+
+// AsPriorityQueue returns a view of the heap as a common.PriorityQueueOf, which pops the maximum first; changes
+// through the view are made to the heap.
+func (ibh *IndexedBinaryHeap[T]) AsPriorityQueue() common.PriorityQueueOf[T] {
+	return indexedBinaryHeapQueue[T]{ibh}
+}
+
+// the adapter making MinMaxHeap a common.PriorityQueueOf.
+type minMaxHeapQueue[T any] struct {
+	*MinMaxHeap[T]
+}
+
+func (mmhq minMaxHeapQueue[T]) Push(v T) {
+	mmhq.Insert(v)
+}
+
+func (mmhq minMaxHeapQueue[T]) Peek() (T, error) {
+	return mmhq.PeekMin()
+}
+
+func (mmhq minMaxHeapQueue[T]) Pop() (T, error) {
+	return mmhq.PopMin()
+}
+
+// AsPriorityQueue returns a view of the heap as a common.PriorityQueueOf, which pops the minimum first; changes
+// through the view are made to the heap.
+func (mmh *MinMaxHeap[T]) AsPriorityQueue() common.PriorityQueueOf[T] {
+	return minMaxHeapQueue[T]{mmh}
+}
+
+// the adapter making a common.MergeableHeap a common.PriorityQueueOf; val returns the value of a node.
+type mergeableHeapQueue[T any, N any, H any] struct {
+	common.MergeableHeap[T, N, H]
+	val func(node N) T
+}
+
+func (mhq mergeableHeapQueue[T, N, H]) Push(v T) {
+	mhq.Insert(v)
+}
+
+func (mhq mergeableHeapQueue[T, N, H]) Peek() (T, error) {
+	if mhq.IsEmpty() {
+		var zero T
+		return zero, errors.New(errorNoElement)
+	}
+	return mhq.val(mhq.Minimum()), nil
+}
+
+func (mhq mergeableHeapQueue[T, N, H]) Pop() (T, error) {
+	if mhq.IsEmpty() {
+		var zero T
+		return zero, errors.New(errorNoElement)
+	}
+	return mhq.val(mhq.ExtractMin()), nil
+}
+
+// AsPriorityQueue returns a view of the heap as a common.PriorityQueueOf, which pops the minimum first; changes
+// through the view are made to the heap.
+func (fib *FibonacciHeap[T]) AsPriorityQueue() common.PriorityQueueOf[T] {
+	return mergeableHeapQueue[T, *FibNode[T], *FibonacciHeap[T]]{fib, func(node *FibNode[T]) T { return node.Val }}
+}
+
+// AsPriorityQueue returns a view of the heap as a common.PriorityQueueOf, which pops the minimum first; changes
+// through the view are made to the heap.
+func (ph *PairingHeap[T]) AsPriorityQueue() common.PriorityQueueOf[T] {
+	return mergeableHeapQueue[T, *PairingNode[T], *PairingHeap[T]]{ph, func(node *PairingNode[T]) T { return node.Val }}
+}
+
+// AsPriorityQueue returns a view of the heap as a common.PriorityQueueOf, which pops the minimum first; changes
+// through the view are made to the heap.
+func (bh *BinomialHeap[T]) AsPriorityQueue() common.PriorityQueueOf[T] {
+	return mergeableHeapQueue[T, *BinomialNode[T], *BinomialHeap[T]]{bh, func(node *BinomialNode[T]) T { return node.Val }}
+}
@@ -0,0 +1,175 @@
+package generics
+
+import (
+	"errors"
+	"some-data-structures/common"
+)
+
+// Deque
+//
+// The double-ended queue structure. Please use NewDeque() as the safe constructor; the zero value is also an
+// empty deque.
+//
+// It is backed by a ring buffer whose size is always a power of 2, so the values can be pushed & popped at both ends
+// in amortized O(1), and accessed by index in O(1). The buffer doubles when it is full and halves when it is less
+// than a quarter full.
+type Deque[T any] struct {
+	buf []T
+	head int  // the position of the front value in buf
+	n int  // the number of values
+}
+
+func (dq *Deque[T]) Len() int {
+	return dq.n
+}
+
+func (dq *Deque[T]) HasNext() bool {
+	return dq.n > 0
+}
+
+// IsEmpty returns true if the deque has no values.
+func (dq *Deque[T]) IsEmpty() bool {
+	return dq.n == 0
+}
+
+// returns the position in buf of the i-th value from the front.
+func (dq *Deque[T]) pos(i int) int {
+	return (dq.head + i) & (len(dq.buf) - 1)
+}
+
+// moves the values to a new buffer of the size, starting from position 0.
+func (dq *Deque[T]) resize(size int) {
+	buf := make([]T, size)
+	if dq.head + dq.n <= len(dq.buf) {
+		copy(buf, dq.buf[dq.head:dq.head + dq.n])
+	} else {  // the values wrap around the end of the buffer
+		m := copy(buf, dq.buf[dq.head:])
+		copy(buf[m:], dq.buf[:dq.n - m])
+	}
+	dq.buf = buf
+	dq.head = 0
+}
+
+// doubles the buffer if it is full; the buffer of a zero-value deque is allocated here.
+func (dq *Deque[T]) grow() {
+	if len(dq.buf) == 0 {
+		dq.buf = make([]T, minDequeSize)
+	} else if dq.n == len(dq.buf) {
+		dq.resize(len(dq.buf) << 1)
+	}
+}
+
+// halves the buffer if it is less than a quarter full.
+func (dq *Deque[T]) shrink() {
+	if len(dq.buf) > minDequeSize && dq.n <= len(dq.buf) >> 2 {
+		dq.resize(len(dq.buf) >> 1)
+	}
+}
+
+// PushFront pushes v to the front of the deque.
+func (dq *Deque[T]) PushFront(v T) {
+	dq.grow()
+	dq.head = dq.pos(-1)
+	dq.buf[dq.head] = v
+	dq.n ++
+}
+
+// PushBack pushes v to the back of the deque.
+func (dq *Deque[T]) PushBack(v T) {
+	dq.grow()
+	dq.buf[dq.pos(dq.n)] = v
+	dq.n ++
+}
+
+// PopFront pops out and returns the value at the front of the deque.
+func (dq *Deque[T]) PopFront() (T, error) {
+	var zero T
+	if dq.n == 0 {
+		return zero, errors.New(errorNoElement)
+	}
+	v := dq.buf[dq.head]
+	dq.buf[dq.head] = zero  // so the value can be collected
+	dq.head = dq.pos(1)
+	dq.n --
+	dq.shrink()
+	return v, nil
+}
+
+// PopBack pops out and returns the value at the back of the deque.
+func (dq *Deque[T]) PopBack() (T, error) {
+	var zero T
+	if dq.n == 0 {
+		return zero, errors.New(errorNoElement)
+	}
+	i := dq.pos(dq.n - 1)
+	v := dq.buf[i]
+	dq.buf[i] = zero
+	dq.n --
+	dq.shrink()
+	return v, nil
+}
+
+// PeekFront returns the value at the front of the deque without popping it.
+func (dq *Deque[T]) PeekFront() (T, error) {
+	if dq.n == 0 {
+		var zero T
+		return zero, errors.New(errorNoElement)
+	}
+	return dq.buf[dq.head], nil
+}
+
+// PeekBack returns the value at the back of the deque without popping it.
+func (dq *Deque[T]) PeekBack() (T, error) {
+	if dq.n == 0 {
+		var zero T
+		return zero, errors.New(errorNoElement)
+	}
+	return dq.buf[dq.pos(dq.n - 1)], nil
+}
+
+// At returns the i-th value from the front, starting from 0.
+func (dq *Deque[T]) At(i int) (T, error) {
+	if i < 0 || i >= dq.n {
+		var zero T
+		return zero, errors.New(errorInvalidIndex)
+	}
+	return dq.buf[dq.pos(i)], nil
+}
+
+// Set replaces the i-th value from the front, starting from 0.
+func (dq *Deque[T]) Set(i int, v T) error {
+	if i < 0 || i >= dq.n {
+		return errors.New(errorInvalidIndex)
+	}
+	dq.buf[dq.pos(i)] = v
+	return nil
+}
+
+// Values returns the values in the deque, from the front to the back.
+func (dq *Deque[T]) Values() []T {
+	r := make([]T, dq.n)
+	for i := range r {
+		r[i] = dq.buf[dq.pos(i)]
+	}
+	return r
+}
+
+// Clear removes all the values and releases the buffer.
+func (dq *Deque[T]) Clear() {
+	dq.buf = make([]T, minDequeSize)
+	dq.head = 0
+	dq.n = 0
+}
+
+// Copy makes a deep copy.
+func (dq *Deque[T]) Copy() *Deque[T] {
+	buf := make([]T, len(dq.buf))
+	for i := 0; i < dq.n; i ++ {
+		buf[i] = common.CopyAs(dq.buf[dq.pos(i)])
+	}
+	return &Deque[T]{buf: buf, head: 0, n: dq.n}
+}
+
+func NewDeque[T any]() *Deque[T] {
+	return &Deque[T]{buf: make([]T, minDequeSize)}
+}
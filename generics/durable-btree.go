@@ -0,0 +1,314 @@
+package generics
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+const walFileName string = "wal"
+const snapshotFileName string = "snapshot"
+const walRecordHeaderSize int = 17  // checksum (4 bytes), sequence number (8 bytes), operation (1 byte) & length (4 bytes)
+
+const (
+	walInsert byte = iota + 1
+	walDelete
+)
+
+// DurableBTree
+//
+// The BTree whose modifications survive crashes. Please use OpenDurableBTree() as the safe constructor, and call Close()
+// when the tree is no longer used.
+//
+// The tree itself lives in memory. Every Insert and Delete is appended to a write-ahead log (WAL) before it is applied,
+// and Checkpoint writes a snapshot of the whole tree and truncates the log. When opened, the tree loads the latest
+// snapshot and replays the log; a torn record (e.g., written partially when the process crashes) is detected by its
+// checksum, and the log is truncated there.
+//
+// A record is written to the file before Insert or Delete returns, so it survives a crash of the process;
+// call Sync to make sure it also survives a crash of the operating system.
+//
+// .
+//
+// Layout of a record: the CRC-32 checksum of the rest of the record, the sequence number, the operation,
+// the length of the value and the value encoded by the codec. The snapshot starts with the sequence number of
+// the last record it includes, followed by the tree written by BTree.WriteTo.
+type DurableBTree[T any] struct {
+	tree *BTree[T]
+	dir string
+	wal *os.File
+	seq uint64  // the sequence number of the last record
+	err error  // the first I/O error; the tree stops working once it is set
+}
+
+// Search returns the value of the FIRST corresponding node if that node exists in the tree.
+func (dbt *DurableBTree[T]) Search(val T) (T, bool) {
+	if node, i, b := dbt.tree.Search(val); b {
+		return node.Keys[i], true
+	}
+	var zero T
+	return zero, false
+}
+
+// Values returns all the values in the tree in an ordered manner.
+func (dbt *DurableBTree[T]) Values() []T {
+	return dbt.tree.Values()
+}
+
+// NumOfElements returns the number of elements in the tree.
+func (dbt *DurableBTree[T]) NumOfElements() int {
+	return dbt.tree.NumOfElements()
+}
+
+// Len returns the number of elements in the tree; same as NumOfElements.
+func (dbt *DurableBTree[T]) Len() int {
+	return dbt.tree.NumOfElements()
+}
+
+// IsEmpty returns true if the tree has no elements.
+func (dbt *DurableBTree[T]) IsEmpty() bool {
+	return dbt.tree.NumOfElements() == 0
+}
+
+// appends a record to the log.
+func (dbt *DurableBTree[T]) log(op byte, val T) error {
+	if dbt.err != nil {
+		return dbt.err
+	}
+	var payload bytes.Buffer
+	if err := dbt.tree.codec.Encode(&payload, val); err != nil {
+		return err  // nothing is written, so the tree still works
+	}
+	record := make([]byte, walRecordHeaderSize, walRecordHeaderSize + payload.Len())
+	binary.BigEndian.PutUint64(record[4:], dbt.seq + 1)
+	record[12] = op
+	binary.BigEndian.PutUint32(record[13:], uint32(payload.Len()))
+	record = append(record, payload.Bytes()...)
+	binary.BigEndian.PutUint32(record, crc32.ChecksumIEEE(record[4:]))
+	if _, err := dbt.wal.Write(record); err != nil {
+		dbt.err = err
+		return err
+	}
+	dbt.seq ++
+	return nil
+}
+
+// Insert logs and inserts a new value into the tree.
+func (dbt *DurableBTree[T]) Insert(val T) error {
+	if err := dbt.log(walInsert, val); err != nil {
+		return err
+	}
+	dbt.tree.Insert(val)
+	return nil
+}
+
+// Delete logs and deletes the first corresponding value, and returns a boolean indicating whether the deletion is
+// successful.
+//
+// Nothing is logged if the value does not exist.
+func (dbt *DurableBTree[T]) Delete(val T) (bool, error) {
+	if _, _, b := dbt.tree.Search(val); !b {
+		return false, dbt.err
+	}
+	if err := dbt.log(walDelete, val); err != nil {
+		return false, err
+	}
+	return dbt.tree.Delete(val), nil
+}
+
+// Checkpoint writes a snapshot of the tree and truncates the log.
+//
+// The snapshot replaces the old one atomically, and the directory is synced before the log is truncated, so the
+// rename is stored first; if a crash happens before the log is truncated, the records included in the snapshot
+// are skipped by the next replay.
+func (dbt *DurableBTree[T]) Checkpoint() error {
+	if dbt.err != nil {
+		return dbt.err
+	}
+	path := filepath.Join(dbt.dir, snapshotFileName)
+	tmp, err := os.Create(path + ".tmp")
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(tmp)
+	err = binary.Write(w, binary.BigEndian, dbt.seq)
+	if err == nil {
+		_, err = dbt.tree.WriteTo(w)
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(path + ".tmp", path)
+	}
+	if err != nil {
+		_ = os.Remove(path + ".tmp")
+		return err  // the old snapshot & the log are untouched
+	}
+	if err := syncDir(dbt.dir); err != nil {
+		return err  // the log is kept, as the rename may not be stored yet
+	}
+
+	if err = dbt.wal.Truncate(0); err == nil {
+		_, err = dbt.wal.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		dbt.err = err
+	}
+	return err
+}
+
+// syncDir commits the entries of the directory, e.g., a renamed file, to stable storage.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	err = d.Sync()
+	if cerr := d.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// Sync commits the log to stable storage.
+func (dbt *DurableBTree[T]) Sync() error {
+	if dbt.err != nil {
+		return dbt.err
+	}
+	return dbt.wal.Sync()
+}
+
+// Close syncs and closes the log; the tree can not be used afterwards.
+func (dbt *DurableBTree[T]) Close() error {
+	err := dbt.Sync()
+	if cerr := dbt.wal.Close(); err == nil {
+		err = cerr
+	}
+	if dbt.err == nil {
+		dbt.err = os.ErrClosed
+	}
+	return err
+}
+
+// loads the snapshot if it exists.
+func (dbt *DurableBTree[T]) loadSnapshot() error {
+	file, err := os.Open(filepath.Join(dbt.dir, snapshotFileName))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	defer file.Close()
+	r := bufio.NewReader(file)
+	if err = binary.Read(r, binary.BigEndian, &dbt.seq); err != nil {
+		return ErrCorruptedData
+	}
+	if _, err = dbt.tree.ReadFrom(r); err == io.ErrUnexpectedEOF {
+		err = ErrCorruptedData
+	}
+	return err
+}
+
+// replays the log from the beginning, and truncates it at the first torn record.
+func (dbt *DurableBTree[T]) replay() error {
+	info, err := dbt.wal.Stat()
+	if err != nil {
+		return err
+	}
+	r := bufio.NewReader(dbt.wal)
+	offset, size := int64(0), info.Size()
+	header := make([]byte, walRecordHeaderSize)
+	for {
+		if _, err := io.ReadFull(r, header); err != nil {
+			break
+		}
+		n := int64(binary.BigEndian.Uint32(header[13:]))
+		if offset + int64(walRecordHeaderSize) + n > size {
+			break
+		}
+		record := make([]byte, walRecordHeaderSize + int(n))
+		copy(record, header)
+		if _, err := io.ReadFull(r, record[walRecordHeaderSize:]); err != nil {
+			break
+		}
+		if crc32.ChecksumIEEE(record[4:]) != binary.BigEndian.Uint32(record) {
+			break
+		}
+		payload := bytes.NewReader(record[walRecordHeaderSize:])
+		val, err := dbt.tree.codec.Decode(payload)
+		if err != nil || payload.Len() != 0 {
+			break
+		}
+
+		offset += int64(len(record))
+		seq := binary.BigEndian.Uint64(record[4:])
+		if seq <= dbt.seq {  // already included in the snapshot
+			continue
+		}
+		if seq != dbt.seq + 1 {  // a record is missing
+			return ErrCorruptedData
+		}
+		switch record[12] {
+		case walInsert:
+			dbt.tree.Insert(val)
+		case walDelete:
+			dbt.tree.Delete(val)
+		default:
+			return ErrCorruptedData
+		}
+		dbt.seq = seq
+	}
+
+	if offset < size {  // drops the torn record & everything after it
+		if err := dbt.wal.Truncate(offset); err != nil {
+			return err
+		}
+	}
+	_, err = dbt.wal.Seek(offset, io.SeekStart)
+	return err
+}
+
+// OpenDurableBTree opens the DurableBTree stored in the directory, or creates a new one if the directory is empty.
+//
+// It loads the snapshot & replays the log. t is only used for a new tree; a snapshot keeps its own minimum degree.
+//
+// t must > 1; otherwise it returns an error.
+func OpenDurableBTree[T any](dir string, t int, compare func(a, b T) int, codec Codec[T]) (*DurableBTree[T], error) {
+	tree := NewBTree(t, compare)
+	if tree == nil {
+		return nil, errors.New("the minimum degree t must be > 1")
+	}
+	if codec == nil {
+		return nil, ErrNoCodec
+	}
+	tree.SetCodec(codec)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	dbt := &DurableBTree[T]{tree: tree, dir: dir}
+	if err := dbt.loadSnapshot(); err != nil {
+		return nil, err
+	}
+	wal, err := os.OpenFile(filepath.Join(dir, walFileName), os.O_RDWR | os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	dbt.wal = wal
+	if err = dbt.replay(); err != nil {
+		_ = wal.Close()
+		return nil, err
+	}
+	return dbt, nil
+}
@@ -0,0 +1,419 @@
+package generics
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"some-data-structures/common"
+)
+//TODO: alternative: make a node list a real list with loop; however, this may lead to lower performance due to slicing
+// and appending
+
+// FibonacciHeap
+//
+// The fibonacci heap structure, which is an ordered min-heap. Please use NewFibonacciHeap() as the safe constructor.
+//
+// Attributes:
+//
+// Min *FibNode[T]: a pointer to the minimum node.
+//
+// compare func(a, b T) int
+//
+// .
+//
+// compare is the function for comparing different node values;
+// it should return 1 if a > b , 0 if a == b, -1 if a < b.
+//
+// .
+//
+// Both inputs of the compare function are of type T. When T is interface{}, the second input may have variant types,
+// and a tricky compare method can relax the conditions for Search and Delete; see examples for details.
+type FibonacciHeap[T any] struct {
+	n int
+	Min *FibNode[T]
+	compare func(a, b T) int
+}
+
+// NumOfElements returns the number of nodes in the heap.
+func (fib *FibonacciHeap[T]) NumOfElements() int {
+	return fib.n
+}
+
+// inserts a new node to the root list. It does not update fib.n. It does not update Min unless the heap is empty.
+func (fib *FibonacciHeap[T]) insert(newNode *FibNode[T]) {
+	if fib.Min == nil {  // creating a new root list
+		newNode.Left = newNode
+		newNode.Right = newNode
+		fib.Min = newNode
+	} else {  // insert into the existing root list
+		min := fib.Min
+		rightSibling := min.Right
+		min.Right = newNode
+		newNode.Left = min
+		rightSibling.Left = newNode
+		newNode.Right = rightSibling
+	}
+}
+
+// (simply) removes a node from the root list. It does not modify the attributes of the node and fib.n,
+// and does not update Min unless the heap is empty after the deletion.
+func (fib *FibonacciHeap[T]) removeFromRoot(node *FibNode[T]) {
+	if node.Right == node {  // is the only node
+		fib.Min = nil
+	} else {
+		left := node.Left
+		right := node.Right
+		left.Right = right
+		right.Left = left
+	}
+}
+
+// Insert inserts a new val into the heap and returns a pointer to the inserted node.
+func (fib *FibonacciHeap[T]) Insert(val T) *FibNode[T] {
+	newNode := NewFibNode(val)
+	fib.insert(newNode)
+	fib.n ++
+	if fib.compare(fib.Min.Val, newNode.Val) == 1 {  // replace fib.Min if necessary
+		fib.Min = newNode
+	}
+	return newNode
+}
+
+// Minimum returns the min node of the heap; it won't change the heap.
+func (fib *FibonacciHeap[T]) Minimum() *FibNode[T] {
+	return fib.Min
+}
+
+// Union returns the union of the 2 fibonacci heaps in O(1).
+//
+// The nodes are moved into the new heap, so the pointers returned by Insert still work on the new heap;
+// both old heaps become empty. Please use Merge to keep the old heaps.
+func (fib *FibonacciHeap[T]) Union(other *FibonacciHeap[T]) *FibonacciHeap[T] {
+	h := NewFibonacciHeap(fib.compare)
+	h.Min = fib.Min
+
+	// combine the 2 root lists
+	if fib.Min == nil {
+		h.Min = other.Min
+	} else if other.Min != nil && other != fib {
+		fibMin := fib.Min
+		rightSibling := fibMin.Right
+		otherMin := other.Min
+		otherRightSibling := otherMin.Right
+
+		fibMin.Right = otherRightSibling
+		otherRightSibling.Left = fibMin
+		otherMin.Right = rightSibling
+		rightSibling.Left = otherMin
+
+		// find new Min
+		if fib.compare(fib.Min.Val, other.Min.Val) == 1 {
+			h.Min = other.Min
+		}
+	}
+
+	h.n = fib.n
+	if other != fib {
+		h.n += other.n
+	}
+
+	// the old heaps no longer own the nodes
+	fib.Min, fib.n = nil, 0
+	other.Min, other.n = nil, 0
+
+	return h
+}
+
+// Merge returns a new heap with the values of both heaps, and keeps both old heaps unchanged.
+//
+// The values are copied by common.CopyAs; the pointers returned by Insert do not work on the new heap.
+func (fib *FibonacciHeap[T]) Merge(other *FibonacciHeap[T]) *FibonacciHeap[T] {
+	h := NewFibonacciHeap(fib.compare)
+	for _, heap := range []*FibonacciHeap[T]{fib, other} {
+		for _, v := range heap.Values() {
+			h.Insert(common.CopyAs(v))
+		}
+	}
+	return h
+}
+
+// links node x and y and makes y a child of x. x and y should both be in the root list!
+func (fib *FibonacciHeap[T]) link(y, x *FibNode[T]) {
+	fib.removeFromRoot(y)
+	fib.appendChild(x, y)
+	y.Marked = false
+}
+
+// append the child node to node x as a new child.
+func (fib *FibonacciHeap[T]) appendChild(x, child *FibNode[T]) {
+	if x.Child == nil {  // x does not have a child
+		x.Child = child
+		child.Left = child
+		child.Right = child
+		child.Parent = x
+	} else {  // x has at least one child
+		oldChild := x.Child
+		rightSibling := x.Child.Right
+
+		oldChild.Right = child
+		child.Left = oldChild
+		rightSibling.Left = child
+		child.Right = rightSibling
+
+		child.Parent = x
+	}
+	x.Degree ++
+}
+
+// consolidating the root nodes by reducing the number of nodes in the root list repeatedly.
+func (fib *FibonacciHeap[T]) consolidate() {
+	// the degree is bounded by log_phi(n) ~= 1.44 * log2(n); a grows in case the bound is exceeded
+	a := make([]*FibNode[T], int(1.45 * math.Log2(float64(fib.n))) + 2)
+
+	cur := fib.Min
+	count := make(map[*FibNode[T]]bool)
+	for cur != nil && !count[cur] {  // loop through all the root nodes
+		x := cur
+		right := cur.Right
+		count[x] = true
+		d := x.Degree
+
+		for d < len(a) && a[d] != nil {  // find root nodes with the same degree and link them together
+			y := a[d]
+			if fib.compare(x.Val, y.Val) == 1 {
+				x, y = y, x
+				cur = x  // update cur here as well
+			}
+			fib.link(y, x)
+			a[d] = nil
+			d ++
+		}
+
+		for d >= len(a) {
+			a = append(a, nil)
+		}
+		a[d] = x
+		cur = right
+	}
+
+	fib.Min = nil
+
+	for i := 0; i < len(a); i ++ {
+		if a[i] != nil {
+			fib.insert(a[i])
+			if fib.compare(fib.Min.Val, a[i].Val) == 1 {
+				fib.Min = a[i]
+			}
+		}
+	}
+}
+
+// ExtractMin pops out the minimum node of the heap; it will change the heap.
+func (fib *FibonacciHeap[T]) ExtractMin() *FibNode[T] {
+	z := fib.Min
+	if z != nil {
+		child := z.Child
+		if child != nil {
+			child.Left.Right = nil  // break the child list; it is ok to break here because we will reset siblings later
+		}
+		for child != nil {
+			nextChild := child.Right
+			fib.insert(child)  // this will change the siblings of the child
+			child.Parent = nil
+			child = nextChild
+		}
+		fib.removeFromRoot(z)
+		if z == z.Right {  // is the only node
+			fib.Min = nil
+		} else {
+			fib.Min = z.Right
+			fib.consolidate()
+		}
+		fib.n --
+		z.Left, z.Right, z.Child, z.Degree = nil, nil, nil, 0  // detaches z from the heap
+	}
+	return z
+}
+
+// removes x from the child list of y and decrease the degree of y by 1. It does not update x.
+func (fib *FibonacciHeap[T]) removeChild(x, y *FibNode[T]) {
+	if y.Degree == 1 {
+		y.Child = nil
+	} else if y.Child == x {
+		y.Child = x.Right
+	}
+	// reconnect the list
+	x.Left.Right = x.Right
+	x.Right.Left = x.Left
+	y.Degree --
+}
+
+// cuts the link between node x and its parent node y, making x a root node.
+func (fib *FibonacciHeap[T]) cut(x, y *FibNode[T]) {
+	fib.removeChild(x, y)
+	fib.insert(x)
+	x.Parent = nil
+	x.Marked = false
+}
+
+// stops until reaching a root node or an unmarked node.
+func (fib *FibonacciHeap[T]) cascadingCut(y *FibNode[T]) {
+	z := y.Parent
+	if z != nil {
+		if !y.Marked {
+			y.Marked = true
+		} else {
+			fib.cut(y, z)
+			fib.cascadingCut(z)
+		}
+	}
+}
+
+// returns true if the node is in a heap; every node in a heap is in a circular sibling list, and extracted or
+// deleted nodes are detached by ExtractMin.
+func (fib *FibonacciHeap[T]) contains(node *FibNode[T]) bool {
+	return node != nil && fib.Min != nil && node.Left != nil
+}
+
+// DecreaseKey decrease the val of a node to newVal.
+func (fib *FibonacciHeap[T]) DecreaseKey(node *FibNode[T], newVal T) error {
+	if !fib.contains(node) {
+		return errors.New(errorNoElement)
+	}
+	if fib.compare(newVal, node.Val) == 1 {
+		return errors.New("new key cannot be greater than the old key")
+	}
+
+	node.Val = newVal
+	y := node.Parent
+
+	if y != nil && fib.compare(y.Val, node.Val) == 1 {
+		fib.cut(node, y)
+		fib.cascadingCut(y)
+	}
+	if fib.compare(fib.Min.Val, node.Val) == 1 {
+		fib.Min = node
+	}
+
+	return nil
+}
+
+// Delete deletes a node from the heap.
+//
+// It returns an error if the node is not in the heap, e.g., it has been extracted or deleted.
+func (fib *FibonacciHeap[T]) Delete(node *FibNode[T]) error {
+	if !fib.contains(node) {
+		return errors.New(errorNoElement)
+	}
+	// same as decreasing the key to the minimum possible value, without comparing the values
+	if y := node.Parent; y != nil {
+		fib.cut(node, y)
+		fib.cascadingCut(y)
+	}
+	fib.Min = node
+	fib.ExtractMin()
+	return nil
+}
+
+// Len returns the number of nodes in the heap.
+func (fib *FibonacciHeap[T]) Len() int {
+	return fib.n
+}
+
+// IsEmpty returns true if the heap has no nodes.
+func (fib *FibonacciHeap[T]) IsEmpty() bool {
+	return fib.n == 0
+}
+
+// Values returns all the values in the heap; the order is not specified.
+func (fib *FibonacciHeap[T]) Values() []T {
+	r := make([]T, 0, fib.n)
+	var walk func(first *FibNode[T])
+	walk = func(first *FibNode[T]) {
+		if first == nil {
+			return
+		}
+		cur := first
+		for {
+			r = append(r, cur.Val)
+			walk(cur.Child)
+			cur = cur.Right
+			if cur == first {
+				return
+			}
+		}
+	}
+	walk(fib.Min)
+	return r
+}
+
+// Validate checks the invariants of the heap and returns the first violation found; it returns nil for a valid heap.
+//
+// The invariants are: the sibling lists are circular and doubly linked, every node points to its parent,
+// the degree is the number of children, a child is not smaller than its parent, Min is the smallest root,
+// the degree is bounded by log_phi(n), and the number of nodes is n.
+//
+// Warning: it visits every node and is expensive.
+func (fib *FibonacciHeap[T]) Validate() error {
+	count := 0
+	maxDegree := int(math.Log(float64(fib.n)) / math.Log(math.Phi))
+	visited := make(map[*FibNode[T]]bool)
+	var check func(first, parent *FibNode[T]) (int, error)
+	check = func(first, parent *FibNode[T]) (int, error) {
+		siblings := 0
+		cur := first
+		for {
+			if visited[cur] {
+				return 0, fmt.Errorf("node %v is visited twice", cur.Val)
+			}
+			visited[cur] = true
+			count ++
+			siblings ++
+			if cur.Right == nil || cur.Left == nil || cur.Right.Left != cur || cur.Left.Right != cur {
+				return 0, fmt.Errorf("the sibling list of node %v is broken", cur.Val)
+			}
+			if cur.Parent != parent {
+				return 0, fmt.Errorf("node %v has a wrong parent", cur.Val)
+			}
+			if parent != nil && fib.compare(parent.Val, cur.Val) == 1 {
+				return 0, fmt.Errorf("node %v is smaller than its parent %v", cur.Val, parent.Val)
+			}
+			if parent == nil && fib.compare(fib.Min.Val, cur.Val) == 1 {
+				return 0, fmt.Errorf("root %v is smaller than Min %v", cur.Val, fib.Min.Val)
+			}
+			if cur.Degree > maxDegree {
+				return 0, fmt.Errorf("node %v has degree %d > %d", cur.Val, cur.Degree, maxDegree)
+			}
+			children := 0
+			if cur.Child != nil {
+				var err error
+				if children, err = check(cur.Child, cur); err != nil {
+					return 0, err
+				}
+			}
+			if children != cur.Degree {
+				return 0, fmt.Errorf("node %v has %d children but degree %d", cur.Val, children, cur.Degree)
+			}
+			cur = cur.Right
+			if cur == first {
+				return siblings, nil
+			}
+		}
+	}
+	if fib.Min != nil {
+		if _, err := check(fib.Min, nil); err != nil {
+			return err
+		}
+	}
+	if count != fib.n {
+		return fmt.Errorf("expected %d nodes, found %d", fib.n, count)
+	}
+	return nil
+}
+
+// NewFibonacciHeap returns an empty FibonacciHeap object.
+func NewFibonacciHeap[T any](compare func(a, b T) int) *FibonacciHeap[T] {
+	return &FibonacciHeap[T]{compare: compare}
+}
+
+
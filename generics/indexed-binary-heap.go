@@ -0,0 +1,159 @@
+package generics
+
+import (
+	"errors"
+)
+
+// HeapItem
+//
+// The handle of a value in IndexedBinaryHeap, returned by Insert.
+//
+// Val T: the current key; please use IndexedBinaryHeap.UpdateKey to change it.
+type HeapItem[T any] struct {
+	Val T
+	index int  // the position in the heap; 0 once the item is removed
+	heap *IndexedBinaryHeap[T]
+}
+
+// IndexedBinaryHeap
+//
+// The binary heap which tracks the position of every value, so that a value can be updated or removed through
+// the handle returned by Insert, e.g., for Dijkstra's algorithm. Please use NewIndexedBinaryHeap() as the safe
+// constructor.
+//
+// Same as BinaryHeap, the maximum (depending on the compare method) is at the top.
+//
+// .
+//
+// compare is the function for comparing different node values;
+// it should return 1 if a > b , 0 if a == b, -1 if a < b
+type IndexedBinaryHeap[T any] struct {
+	heap []*HeapItem[T]  // index 0 is reserved
+	compare func(a, b T) int
+}
+
+func (ibh *IndexedBinaryHeap[T]) Size() int {
+	return len(ibh.heap) - 1
+}
+
+// Len returns the number of items in the heap; same as Size.
+func (ibh *IndexedBinaryHeap[T]) Len() int {
+	return len(ibh.heap) - 1
+}
+
+// IsEmpty returns true if the heap has no items.
+func (ibh *IndexedBinaryHeap[T]) IsEmpty() bool {
+	return len(ibh.heap) <= 1
+}
+
+// Values returns the keys of the items in the heap; the order is not specified.
+func (ibh *IndexedBinaryHeap[T]) Values() []T {
+	r := make([]T, 0, ibh.Size())
+	for _, item := range ibh.heap[1:] {
+		r = append(r, item.Val)
+	}
+	return r
+}
+
+// swaps 2 items and updates their positions.
+func (ibh *IndexedBinaryHeap[T]) swap(i, j int) {
+	ibh.heap[i], ibh.heap[j] = ibh.heap[j], ibh.heap[i]
+	ibh.heap[i].index = i
+	ibh.heap[j].index = j
+}
+
+// moves the item at position i up until its parent is not smaller.
+func (ibh *IndexedBinaryHeap[T]) siftUp(i int) {
+	for i > 1 && ibh.compare(ibh.heap[i].Val, ibh.heap[i / 2].Val) == 1 {
+		ibh.swap(i, i / 2)
+		i = i / 2
+	}
+}
+
+// moves the item at position i down until none of its children is larger; same as BinaryHeap.maxHeapify.
+func (ibh *IndexedBinaryHeap[T]) siftDown(i int) {
+	top := ibh.Size()
+	for {
+		left := i << 1
+		right := left + 1
+		largest := i
+		if left <= top && ibh.compare(ibh.heap[left].Val, ibh.heap[largest].Val) == 1 {
+			largest = left
+		}
+		if right <= top && ibh.compare(ibh.heap[right].Val, ibh.heap[largest].Val) == 1 {
+			largest = right
+		}
+		if largest == i {
+			return
+		}
+		ibh.swap(i, largest)
+		i = largest
+	}
+}
+
+// Insert inserts a key to the proper position in the heap, and returns its handle.
+func (ibh *IndexedBinaryHeap[T]) Insert(key T) *HeapItem[T] {
+	item := &HeapItem[T]{Val: key, index: len(ibh.heap), heap: ibh}
+	ibh.heap = append(ibh.heap, item)
+	ibh.siftUp(item.index)
+	return item
+}
+
+// Contains returns true if the item is still in this heap.
+func (ibh *IndexedBinaryHeap[T]) Contains(item *HeapItem[T]) bool {
+	return item != nil && item.heap == ibh && item.index > 0
+}
+
+// HeapMaximum returns the maximum (or minimum, depending on the compare method) item from the heap
+func (ibh *IndexedBinaryHeap[T]) HeapMaximum() (*HeapItem[T], error) {
+	if ibh.Size() < 1 {
+		return nil, errors.New(errorNoElement)
+	}
+	return ibh.heap[1], nil
+}
+
+// ExtractHeapMaximum extracts and returns the maximum (or minimum, depending on the compare method)
+// item from the heap.
+func (ibh *IndexedBinaryHeap[T]) ExtractHeapMaximum() (*HeapItem[T], error) {
+	if ibh.Size() < 1 {
+		return nil, errors.New(errorNoElement)
+	}
+	item := ibh.heap[1]
+	return item, ibh.Remove(item)
+}
+
+// UpdateKey changes the key of the item, and moves it up or down to the proper position.
+func (ibh *IndexedBinaryHeap[T]) UpdateKey(item *HeapItem[T], key T) error {
+	if !ibh.Contains(item) {
+		return errors.New(errorInvalidIndex)
+	}
+	item.Val = key
+	ibh.siftUp(item.index)
+	ibh.siftDown(item.index)
+	return nil
+}
+
+// Remove removes the item from the heap.
+func (ibh *IndexedBinaryHeap[T]) Remove(item *HeapItem[T]) error {
+	if !ibh.Contains(item) {
+		return errors.New(errorInvalidIndex)
+	}
+	i, last := item.index, ibh.Size()
+	ibh.swap(i, last)
+	ibh.heap[last] = nil
+	ibh.heap = ibh.heap[:last]
+	item.index = 0
+	if i < last {  // the last item is moved to position i
+		ibh.siftUp(i)
+		ibh.siftDown(i)
+	}
+	return nil
+}
+
+// NewIndexedBinaryHeap returns a new IndexedBinaryHeap object with no initial values.
+//
+// compare is the function for comparing different node values;
+// it should return 1 if a > b , 0 if a == b, -1 if a < b.
+func NewIndexedBinaryHeap[T any](compare func(a, b T) int) *IndexedBinaryHeap[T] {
+	return &IndexedBinaryHeap[T]{heap: make([]*HeapItem[T], 1, defaultSize), compare: compare}
+}
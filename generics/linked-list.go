@@ -0,0 +1,248 @@
+package generics
+
+import (
+	"errors"
+)
+
+// LinkedList
+//
+// The basic linked list structure. Please use NewLinkedList() as the safe constructor.
+//
+// Attributes:
+//
+// Head *Node[T]: the head of the linked list. Usually it is a dummy head.
+//
+// compare func(a, b T) int: the compare method.
+//
+// .
+//
+// The list keeps a pointer to the tail and the number of nodes, so Append and Len cost O(1); please modify the list
+// through its methods instead of linking the nodes from Head directly.
+type LinkedList[T any] struct {
+	Head *Node[T]
+	tail *Node[T]  // the last node; will be Head if the list is empty
+	n int
+	compare func(a, b T) int
+}
+
+// Len returns the number of nodes, excluding the dummy head.
+func (ll *LinkedList[T]) Len() int {
+	return ll.n
+}
+
+// IsEmpty returns true if the list has no nodes other than the dummy head.
+func (ll *LinkedList[T]) IsEmpty() bool {
+	return ll.n == 0
+}
+
+// returns the node before the i-th node (starting from 0), i.e., Head if i is 0.
+func (ll *LinkedList[T]) prevOf(i int) *Node[T] {
+	prev := ll.Head
+	for j := 0; j < i; j ++ {
+		prev = prev.Next
+	}
+	return prev
+}
+
+// unlinks the node after prev.
+func (ll *LinkedList[T]) removeAfter(prev *Node[T]) *Node[T] {
+	cur := prev.Next
+	prev.Next = cur.Next
+	cur.Next = nil
+	if cur == ll.tail {
+		ll.tail = prev
+	}
+	ll.n --
+	return cur
+}
+
+// Search returns the first element satisfying the search condition.
+//
+// Will return nil if there is no such element.
+func (ll *LinkedList[T]) Search(v T) *Node[T] {
+	pt := ll.Head
+	for pt.Next != nil {
+		pt = pt.Next
+		if ll.compare(pt.Val, v) == 0 {
+			return pt
+		}
+	}
+	return nil
+}
+
+// Insert inserts v as a new node to the head of this linked list.
+func (ll *LinkedList[T]) Insert(v T) {
+	node := NewNode(v)
+	node.Next = ll.Head.Next
+	ll.Head.Next = node
+	if ll.tail == ll.Head {
+		ll.tail = node
+	}
+	ll.n ++
+}
+
+// Append inserts v as a new node to the tail of this linked list.
+func (ll *LinkedList[T]) Append(v T) {
+	node := NewNode(v)
+	ll.tail.Next = node
+	ll.tail = node
+	ll.n ++
+}
+
+// InsertAt inserts v as the i-th node, starting from 0; i must be in [0, Len()].
+func (ll *LinkedList[T]) InsertAt(i int, v T) error {
+	if i < 0 || i > ll.n {
+		return errors.New(errorInvalidIndex)
+	}
+	if i == ll.n {
+		ll.Append(v)
+		return nil
+	}
+	prev := ll.prevOf(i)
+	node := NewNode(v)
+	node.Next = prev.Next
+	prev.Next = node
+	ll.n ++
+	return nil
+}
+
+// Get returns the value of the i-th node, starting from 0.
+func (ll *LinkedList[T]) Get(i int) (T, error) {
+	if i < 0 || i >= ll.n {
+		var zero T
+		return zero, errors.New(errorInvalidIndex)
+	}
+	return ll.prevOf(i).Next.Val, nil
+}
+
+// RemoveAt removes the i-th node, starting from 0, and returns its value.
+func (ll *LinkedList[T]) RemoveAt(i int) (T, error) {
+	if i < 0 || i >= ll.n {
+		var zero T
+		return zero, errors.New(errorInvalidIndex)
+	}
+	return ll.removeAfter(ll.prevOf(i)).Val, nil
+}
+
+// Delete deletes and returns the first element satisfying the search condition.
+//
+// Will return nil if there is no such element.
+func (ll *LinkedList[T]) Delete(v T) *Node[T] {
+	for prev := ll.Head; prev.Next != nil; prev = prev.Next {
+		if ll.compare(prev.Next.Val, v) == 0 {
+			return ll.removeAfter(prev)
+		}
+	}
+	return nil
+}
+
+// DeleteAll deletes all the elements satisfying the search condition, and returns the number of deleted elements.
+func (ll *LinkedList[T]) DeleteAll(v T) int {
+	count := 0
+	for prev := ll.Head; prev.Next != nil; {
+		if ll.compare(prev.Next.Val, v) == 0 {
+			ll.removeAfter(prev)
+			count ++
+		} else {
+			prev = prev.Next
+		}
+	}
+	return count
+}
+
+// Reverse reverses the list in place.
+func (ll *LinkedList[T]) Reverse() {
+	var prev *Node[T]
+	cur := ll.Head.Next
+	if cur != nil {
+		ll.tail = cur
+	}
+	for cur != nil {
+		next := cur.Next
+		cur.Next = prev
+		prev = cur
+		cur = next
+	}
+	ll.Head.Next = prev
+}
+
+// Concat moves all the nodes of other to the tail of this list in O(1); other becomes empty.
+func (ll *LinkedList[T]) Concat(other *LinkedList[T]) {
+	if other == ll || other.n == 0 {
+		return
+	}
+	ll.tail.Next = other.Head.Next
+	ll.tail = other.tail
+	ll.n += other.n
+	other.Head.Next = nil
+	other.tail = other.Head
+	other.n = 0
+}
+
+// Split keeps the first at nodes in this list, and moves the rest into a new list which is returned; at must be
+// in [0, Len()].
+func (ll *LinkedList[T]) Split(at int) (*LinkedList[T], error) {
+	if at < 0 || at > ll.n {
+		return nil, errors.New(errorInvalidIndex)
+	}
+	r := NewLinkedList(ll.compare)
+	prev := ll.prevOf(at)
+	if prev.Next != nil {
+		r.Head.Next = prev.Next
+		r.tail = ll.tail
+		r.n = ll.n - at
+		prev.Next = nil
+		ll.tail = prev
+		ll.n = at
+	}
+	return r, nil
+}
+
+// Values returns the values from the head to the tail.
+func (ll *LinkedList[T]) Values() []T {
+	r := make([]T, 0, ll.n)
+	for pt := ll.Head.Next; pt != nil; pt = pt.Next {
+		r = append(r, pt.Val)
+	}
+	return r
+}
+
+// ToSlice returns the values from the head to the tail; same as Values.
+func (ll *LinkedList[T]) ToSlice() []T {
+	return ll.Values()
+}
+
+// NewLinkedList returns a LinkedList object.
+//
+// compare is the function for comparing different node values;
+// it should return 1 if a > b , 0 if a == b, -1 if a < b;
+// a should always be an element from the struct other than user input.
+func NewLinkedList[T any](compare func(a, b T) int) *LinkedList[T] {
+	head := DummyNode[T]()
+	return &LinkedList[T]{Head: head, tail: head, compare: compare}
+}
+
+// Iterator returns a cursor walking through the values from the head to the tail.
+//
+// The values are visited lazily; deleting the next node invalidates the cursor.
+func (ll *LinkedList[T]) Iterator() *LinkedListIterator[T] {
+	return &LinkedListIterator[T]{node: ll.Head.Next}
+}
+
+// LinkedListIterator
+//
+// The cursor over a LinkedList. Please use LinkedList.Iterator() to create one.
+type LinkedListIterator[T any] struct {
+	node *Node[T]  // the next node to visit; will be nil if there are no more values
+}
+
+// Next returns the next value and true, or the zero value of T and false if there are no more values.
+func (it *LinkedListIterator[T]) Next() (T, bool) {
+	if it.node == nil {
+		var zero T
+		return zero, false
+	}
+	v := it.node.Val
+	it.node = it.node.Next
+	return v, true
+}
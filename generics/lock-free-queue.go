@@ -0,0 +1,114 @@
+package generics
+
+import (
+	"sync/atomic"
+	"unsafe"
+)
+
+// the node of LockFreeQueue & LockFreeStack.
+type lockFreeNode[T any] struct {
+	val T
+	next unsafe.Pointer  // *lockFreeNode[T]; always accessed atomically in LockFreeQueue
+}
+
+func loadLockFreeNode[T any](p *unsafe.Pointer) *lockFreeNode[T] {
+	return (*lockFreeNode[T])(atomic.LoadPointer(p))
+}
+
+func casLockFreeNode[T any](p *unsafe.Pointer, old, new *lockFreeNode[T]) bool {
+	return atomic.CompareAndSwapPointer(p, unsafe.Pointer(old), unsafe.Pointer(new))
+}
+
+// LockFreeQueue
+//
+// The FIFO queue which is safe for concurrent use without locks, i.e., the Michael-Scott queue. Please use
+// NewLockFreeQueue() as the safe constructor.
+//
+// It has the same Push/Pop/Len surface as Queue. Since another goroutine may pop the last value between HasNext and
+// Pop, please use TryPop when the queue is shared.
+//
+// The popped node stays as the dummy head until the next Pop, so the value popped last is not collected until then.
+type LockFreeQueue[T any] struct {
+	head unsafe.Pointer  // *lockFreeNode[T]; the dummy node before the first value
+	tail unsafe.Pointer  // *lockFreeNode[T]; the last node, or the one before it while a Push is in progress
+	n int64
+}
+
+// Len returns the number of values in the queue.
+//
+// It is only a snapshot when other goroutines are pushing or popping.
+func (q *LockFreeQueue[T]) Len() int {
+	n := atomic.LoadInt64(&q.n)
+	if n < 0 {  // a Pop has finished before the counting of its Push
+		return 0
+	}
+	return int(n)
+}
+
+func (q *LockFreeQueue[T]) HasNext() bool {
+	return loadLockFreeNode[T](&loadLockFreeNode[T](&q.head).next) != nil
+}
+
+// IsEmpty returns true if the queue has no values.
+func (q *LockFreeQueue[T]) IsEmpty() bool {
+	return !q.HasNext()
+}
+
+// Push pushes v into the queue.
+func (q *LockFreeQueue[T]) Push(v T) {
+	node := &lockFreeNode[T]{val: v}
+	for {
+		tail := loadLockFreeNode[T](&q.tail)
+		next := loadLockFreeNode[T](&tail.next)
+		if tail != loadLockFreeNode[T](&q.tail) {
+			continue
+		}
+		if next != nil {  // the tail is behind; helps the other Push to move it
+			casLockFreeNode(&q.tail, tail, next)
+			continue
+		}
+		if casLockFreeNode(&tail.next, nil, node) {
+			casLockFreeNode(&q.tail, tail, node)
+			break
+		}
+	}
+	atomic.AddInt64(&q.n, 1)
+}
+
+// TryPop pops out and returns the first element of the queue, and false if the queue is empty.
+func (q *LockFreeQueue[T]) TryPop() (T, bool) {
+	for {
+		head := loadLockFreeNode[T](&q.head)
+		tail := loadLockFreeNode[T](&q.tail)
+		next := loadLockFreeNode[T](&head.next)
+		if head != loadLockFreeNode[T](&q.head) {
+			continue
+		}
+		if next == nil {
+			var zero T
+			return zero, false
+		}
+		if head == tail {  // the tail is behind
+			casLockFreeNode(&q.tail, tail, next)
+			continue
+		}
+		v := next.val
+		if casLockFreeNode(&q.head, head, next) {
+			atomic.AddInt64(&q.n, -1)
+			return v, true
+		}
+	}
+}
+
+// Pop pops out and returns the first element of the queue.
+//
+// It returns the zero value of T if the queue is empty; please use TryPop to tell the difference.
+func (q *LockFreeQueue[T]) Pop() T {
+	v, _ := q.TryPop()
+	return v
+}
+
+func NewLockFreeQueue[T any]() *LockFreeQueue[T] {
+	dummy := unsafe.Pointer(&lockFreeNode[T]{})
+	return &LockFreeQueue[T]{head: dummy, tail: dummy}
+}
@@ -0,0 +1,80 @@
+package generics
+
+import (
+	"sync/atomic"
+	"unsafe"
+)
+
+// LockFreeStack
+//
+// The stack which is safe for concurrent use without locks, i.e., the Treiber stack. Please use NewLockFreeStack()
+// as the safe constructor.
+//
+// It has the same Push/Pop/Len surface as Stack. Since another goroutine may pop the last value between HasNext and
+// Pop, please use TryPop when the stack is shared.
+type LockFreeStack[T any] struct {
+	top unsafe.Pointer  // *lockFreeNode[T]
+	n int64
+}
+
+// Len returns the number of values in the stack.
+//
+// It is only a snapshot when other goroutines are pushing or popping.
+func (sk *LockFreeStack[T]) Len() int {
+	n := atomic.LoadInt64(&sk.n)
+	if n < 0 {  // a Pop has finished before the counting of its Push
+		return 0
+	}
+	return int(n)
+}
+
+func (sk *LockFreeStack[T]) HasNext() bool {
+	return atomic.LoadPointer(&sk.top) != nil
+}
+
+// IsEmpty returns true if the stack has no values.
+func (sk *LockFreeStack[T]) IsEmpty() bool {
+	return atomic.LoadPointer(&sk.top) == nil
+}
+
+// Push pushes v into the stack.
+func (sk *LockFreeStack[T]) Push(v T) {
+	node := &lockFreeNode[T]{val: v}
+	for {
+		top := atomic.LoadPointer(&sk.top)
+		node.next = top  // the node is not shared yet
+		if atomic.CompareAndSwapPointer(&sk.top, top, unsafe.Pointer(node)) {
+			break
+		}
+	}
+	atomic.AddInt64(&sk.n, 1)
+}
+
+// TryPop pops out the element on the top of the stack, and false if the stack is empty.
+//
+// The nodes are never reused, so the CAS cannot suffer from the ABA problem.
+func (sk *LockFreeStack[T]) TryPop() (T, bool) {
+	for {
+		top := (*lockFreeNode[T])(atomic.LoadPointer(&sk.top))
+		if top == nil {
+			var zero T
+			return zero, false
+		}
+		if atomic.CompareAndSwapPointer(&sk.top, unsafe.Pointer(top), top.next) {
+			atomic.AddInt64(&sk.n, -1)
+			return top.val, true
+		}
+	}
+}
+
+// Pop pops out the element on the top of the stack.
+//
+// It returns the zero value of T if the stack is empty; please use TryPop to tell the difference.
+func (sk *LockFreeStack[T]) Pop() T {
+	v, _ := sk.TryPop()
+	return v
+}
+
+func NewLockFreeStack[T any]() *LockFreeStack[T] {
+	return &LockFreeStack[T]{}
+}
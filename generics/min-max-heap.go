@@ -0,0 +1,203 @@
+package generics
+
+import (
+	"errors"
+	"math/bits"
+)
+
+// MinMaxHeap
+//
+// The min-max heap, i.e., a double-ended priority queue; both the minimum and the maximum can be peeked in O(1)
+// and popped in O(log n). Please use NewMinMaxHeap() or NewMinMaxHeapWithValues() as the safe constructor.
+//
+// The nodes on even levels (starting from the root on level 0) are smaller than or equal to their descendants,
+// and the nodes on odd levels are larger than or equal to their descendants.
+//
+// .
+//
+// compare is the function for comparing different node values;
+// it should return 1 if a > b , 0 if a == b, -1 if a < b
+type MinMaxHeap[T any] struct {
+	heap []T  // index 0 is reserved
+	compare func(a, b T) int
+}
+
+func (mmh *MinMaxHeap[T]) Size() int {
+	return len(mmh.heap) - 1
+}
+
+// Len returns the number of values in the heap; same as Size.
+func (mmh *MinMaxHeap[T]) Len() int {
+	return len(mmh.heap) - 1
+}
+
+// IsEmpty returns true if the heap has no values.
+func (mmh *MinMaxHeap[T]) IsEmpty() bool {
+	return len(mmh.heap) <= 1
+}
+
+// Values returns the values in the heap; the order is not specified.
+func (mmh *MinMaxHeap[T]) Values() []T {
+	r := make([]T, mmh.Size())
+	copy(r, mmh.heap[1:])
+	return r
+}
+
+func (mmh *MinMaxHeap[T]) swap(i, j int) {
+	mmh.heap[i], mmh.heap[j] = mmh.heap[j], mmh.heap[i]
+}
+
+// returns true if the node at position i is on a min level.
+func isMinLevel(i int) bool {
+	return (bits.Len(uint(i)) - 1) % 2 == 0
+}
+
+// returns true if heap[i] should be closer to the root than heap[j] on a min level (or a max level if max is true).
+func (mmh *MinMaxHeap[T]) before(i, j int, max bool) bool {
+	if max {
+		return mmh.compare(mmh.heap[i], mmh.heap[j]) == 1
+	}
+	return mmh.compare(mmh.heap[i], mmh.heap[j]) == -1
+}
+
+// moves the node at position i up to the proper position.
+func (mmh *MinMaxHeap[T]) pushUp(i int) {
+	if i <= 1 {
+		return
+	}
+	max := !isMinLevel(i)
+	if mmh.before(i / 2, i, max) {  // e.g., a node on a min level is larger than its parent on a max level
+		mmh.swap(i, i / 2)
+		mmh.pushUpLevels(i / 2, !max)
+	} else {
+		mmh.pushUpLevels(i, max)
+	}
+}
+
+// moves the node at position i up through its grandparents, which are on the same kind of levels.
+func (mmh *MinMaxHeap[T]) pushUpLevels(i int, max bool) {
+	for i > 3 && mmh.before(i, i / 4, max) {
+		mmh.swap(i, i / 4)
+		i = i / 4
+	}
+}
+
+// moves the node at position i down to the proper position.
+func (mmh *MinMaxHeap[T]) pushDown(i int) {
+	max := !isMinLevel(i)
+	n := mmh.Size()
+	for i << 1 <= n {
+		// finds the smallest (or the largest on a max level) among the children & the grandchildren
+		m := i << 1
+		for _, j := range []int{i << 1 + 1, i << 2, i << 2 + 1, i << 2 + 2, i << 2 + 3} {
+			if j <= n && mmh.before(j, m, max) {
+				m = j
+			}
+		}
+		if !mmh.before(m, i, max) {
+			return
+		}
+		mmh.swap(m, i)
+		if m < i << 2 {  // a child
+			return
+		}
+		if mmh.before(m / 2, m, max) {  // the grandchild must stay on the right side of its parent
+			mmh.swap(m, m / 2)
+		}
+		i = m
+	}
+}
+
+// Insert inserts a key to the proper position in the heap.
+func (mmh *MinMaxHeap[T]) Insert(key T) {
+	mmh.heap = append(mmh.heap, key)
+	mmh.pushUp(mmh.Size())
+}
+
+// returns the position of the maximum.
+func (mmh *MinMaxHeap[T]) maxIndex() int {
+	switch mmh.Size() {
+	case 1:
+		return 1
+	case 2:
+		return 2
+	}
+	if mmh.compare(mmh.heap[3], mmh.heap[2]) == 1 {
+		return 3
+	}
+	return 2
+}
+
+// removes the node at position i and returns its value.
+func (mmh *MinMaxHeap[T]) removeAt(i int) T {
+	last := mmh.Size()
+	val := mmh.heap[i]
+	mmh.swap(i, last)
+	var zero T
+	mmh.heap[last] = zero
+	mmh.heap = mmh.heap[:last]
+	if i < last {
+		mmh.pushDown(i)
+	}
+	return val
+}
+
+// PeekMin returns the minimum element.
+func (mmh *MinMaxHeap[T]) PeekMin() (T, error) {
+	if mmh.Size() < 1 {
+		var zero T
+		return zero, errors.New(errorNoElement)
+	}
+	return mmh.heap[1], nil
+}
+
+// PeekMax returns the maximum element.
+func (mmh *MinMaxHeap[T]) PeekMax() (T, error) {
+	if mmh.Size() < 1 {
+		var zero T
+		return zero, errors.New(errorNoElement)
+	}
+	return mmh.heap[mmh.maxIndex()], nil
+}
+
+// PopMin extracts and returns the minimum element.
+func (mmh *MinMaxHeap[T]) PopMin() (T, error) {
+	if mmh.Size() < 1 {
+		var zero T
+		return zero, errors.New(errorNoElement)
+	}
+	return mmh.removeAt(1), nil
+}
+
+// PopMax extracts and returns the maximum element.
+func (mmh *MinMaxHeap[T]) PopMax() (T, error) {
+	if mmh.Size() < 1 {
+		var zero T
+		return zero, errors.New(errorNoElement)
+	}
+	return mmh.removeAt(mmh.maxIndex()), nil
+}
+
+// NewMinMaxHeap returns a new MinMaxHeap object with no initial values.
+//
+// compare is the function for comparing different node values;
+// it should return 1 if a > b , 0 if a == b, -1 if a < b.
+func NewMinMaxHeap[T any](compare func(a, b T) int) *MinMaxHeap[T] {
+	return &MinMaxHeap[T]{heap: make([]T, 1, defaultSize), compare: compare}
+}
+
+// NewMinMaxHeapWithValues returns a new MinMaxHeap object with initial values; it builds the heap in O(n).
+//
+// The values are copied, so changes of values after this creation will not affect the heap.
+//
+// compare is the function for comparing different node values;
+// it should return 1 if a > b , 0 if a == b, -1 if a < b.
+func NewMinMaxHeapWithValues[T any](values []T, compare func(a, b T) int) *MinMaxHeap[T] {
+	newValues := make([]T, len(values) + 1)  // index 0 is reserved
+	copy(newValues[1:], values)
+	mmh := &MinMaxHeap[T]{heap: newValues, compare: compare}
+	for i := len(values) / 2; i > 0; i -- {
+		mmh.pushDown(i)
+	}
+	return mmh
+}
@@ -0,0 +1,173 @@
+package generics
+
+// TreeNode
+//
+// The basic tree node.
+//
+// Attributes:
+//
+// Val T: the value.
+//
+// Left *TreeNode[T]: the smaller (or equal) left child.
+//
+// Right *TreeNode[T]: the bigger right child.
+//
+// Parent *TreeNode[T]: the parent node.
+type TreeNode[T any] struct {
+	Val T
+	Left *TreeNode[T]
+	Right *TreeNode[T]
+	Parent *TreeNode[T]
+}
+
+func NewTreeNode[T any](val T) *TreeNode[T] {
+	return &TreeNode[T]{Val: val}
+}
+
+// RBTreeNode
+//
+// The tree node for red-black-tree.
+//
+// Attributes:
+//
+// Val T: the value.
+//
+// Color bool: if the node is red.
+//
+// Size int: the number of nodes in the subtree rooted at this node, which is 0 for the sentinel.
+//
+// Left *RBTreeNode[T]: the smaller (or equal) left child.
+//
+// Right *RBTreeNode[T]: the bigger right child.
+//
+// Parent *RBTreeNode[T]: the parent node.
+type RBTreeNode[T any] struct {
+	Val   T
+	Color bool
+	Size  int
+	Left  *RBTreeNode[T]
+	Right *RBTreeNode[T]
+	Parent *RBTreeNode[T]
+}
+
+func NewRBTreeNode[T any](val T, isRed bool) *RBTreeNode[T] {
+	return &RBTreeNode[T]{Val: val, Color: isRed, Size: 1}
+}
+
+// BTreeNode The node used as the internal node & the leaf node for B tree
+//
+// Number of children of a node is equal to the number of keys in it plus 1.
+//
+// N int: the number of keys.
+type BTreeNode[T any] struct {
+	IsLeaf bool
+	N int
+	Keys []T
+	Children []*BTreeNode[T]
+	Parent *BTreeNode[T]
+}
+
+// NewBTreeNode returns a new BTreeNode.
+//
+// t int
+//
+//		The minimum degree.
+//		Every node except root must contain at least t-1 keys. The root may contain minimum 1 key.
+//		All nodes (including root) may contain at most 2*t – 1 keys.
+//		t must > 1.
+//
+// Number of children of a node is equal to the number of keys in it plus 1.
+func NewBTreeNode[T any](t int, isLeaf bool) *BTreeNode[T] {
+	return &BTreeNode[T]{IsLeaf: isLeaf, N : 0, Keys: make([]T, 2 * t - 1), Children: make([]*BTreeNode[T], 2 * t)}
+}
+
+// Node
+//
+// The basic node.
+//
+// Attributes:
+//
+// Val T
+//
+// Next *Node[T]  the child node
+type Node[T any] struct {
+	Val T
+	Next *Node[T]
+}
+
+// DummyNode returns a dummy node with val as the zero value of T
+func DummyNode[T any]() *Node[T] {
+	return &Node[T]{}
+}
+
+func NewNode[T any](val T) *Node[T] {
+	return &Node[T]{Val: val}
+}
+
+// FibNode
+//
+// The node structure for fibonacci heap.
+//
+// Attributes:
+//
+// Marked bool: if the node has lost a child node.
+//
+// Degree int: the number of the children of this node.
+//
+// Val T
+//
+// Parent *FibNode[T]: the parent.
+//
+// Child *FibNode[T]: the child.
+//
+// Left *FibNode[T]: the left sibling.
+//
+// Right *FibNode[T]: the right sibling.
+type FibNode[T any] struct {
+	Marked bool  // false by default
+	Degree int  // 0 by default
+	Val T
+	Parent *FibNode[T]
+	Child *FibNode[T]
+	Left *FibNode[T]
+	Right *FibNode[T]
+}
+
+// NewFibNode creates a new Fibonacci node object.
+func NewFibNode[T any](val T) *FibNode[T] {
+	return &FibNode[T]{Val: val}
+}
+
+// PairingNode
+//
+// The node structure for pairing heap; it is also the handle returned by PairingHeap.Insert.
+//
+// Val T: the current value; please use PairingHeap.DecreaseKey to change it.
+type PairingNode[T any] struct {
+	Val T
+	child *PairingNode[T]  // the leftmost child
+	next *PairingNode[T]  // the right sibling
+	prev *PairingNode[T]  // the left sibling, or the parent for the leftmost child
+}
+
+// BinomialNode
+//
+// The handle of a value in binomial heap, returned by BinomialHeap.Insert.
+//
+// The values are swapped between the tree nodes when a key is decreased, so the handle refers to the value
+// instead of a fixed tree node.
+//
+// Val T: the current value; please use BinomialHeap.DecreaseKey to change it.
+type BinomialNode[T any] struct {
+	Val T
+	tree *binomialTree[T]  // the tree node currently holding this value
+}
+
+// the node of a binomial tree.
+type binomialTree[T any] struct {
+	item *BinomialNode[T]
+	degree int
+	parent *binomialTree[T]
+	child *binomialTree[T]  // the child with the highest degree
+	sibling *binomialTree[T]  // the next root in the root list, or the next child with a lower degree
+}
@@ -0,0 +1,673 @@
+package generics
+
+import (
+	"bytes"
+	"container/list"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+)
+
+// ErrPageOverflow is returned when an encoded node does not fit in a page.
+var ErrPageOverflow = errors.New("the node does not fit in a page")
+
+const pagedBTreeMagic string = "PBT1"
+const pagedBTreeHeaderSize int = 32  // magic, page size, t, root, page count, free list head (4 bytes each) & num (8 bytes)
+const pageHeaderSize int = 5  // kind (1 byte) & the number of keys or the next free page (4 bytes)
+const pagedBTreeJournalMagic string = "PBJ1"
+const journalHeaderSize int = 24  // crc32, magic, page size, the number of pages (4 bytes each) & file size (8 bytes)
+
+// the id of a page, i.e., its offset in the file divided by the page size.
+//
+// Page 0 is the file header, so 0 also means "no page".
+type pageID uint32
+
+const (
+	pageInternal byte = iota
+	pageLeaf
+	pageFree
+)
+
+// the in-memory copy of a page, which is a BTreeNode referring to its children by page ids.
+type pageNode[T any] struct {
+	id pageID
+	kind byte
+	n int
+	keys []T
+	children []pageID
+	next pageID  // the next page in the free list; only used by a free page
+	dirty bool
+}
+
+func (p *pageNode[T]) isLeaf() bool {
+	return p.kind == pageLeaf
+}
+
+// pager
+//
+// Reads and writes the pages of a file through an LRU cache.
+//
+// The cache may exceed its capacity during an operation, as the nodes being modified must stay in memory;
+// commit writes the dirty pages back and shrinks the cache at the end of each operation, and rollback drops them
+// if the operation fails before anything is written.
+//
+// Before the pages are overwritten, their committed content is saved in the journal, so an interrupted commit is
+// rolled back by replayJournal when the file is opened again.
+type pager[T any] struct {
+	file *os.File
+	journal *os.File
+	pageSize int
+	capacity int
+	t int
+	codec Codec[T]
+	pages map[pageID]*list.Element  // the values of the elements are *pageNode[T]
+	lru *list.List  // the most recently used page is at the front
+	dirtyPages []*pageNode[T]
+	pageCount pageID  // including the header page
+	freeHead pageID
+
+	// the state of the last commit, for rolling back an operation
+	lastCount pageID
+	lastFreeHead pageID
+	lastRoot pageID
+	lastNum int
+}
+
+// returns an empty node with the full capacity of keys & children.
+func (p *pager[T]) newNode(id pageID, kind byte) *pageNode[T] {
+	return &pageNode[T]{id: id, kind: kind, keys: make([]T, 2 * p.t - 1), children: make([]pageID, 2 * p.t)}
+}
+
+// get returns the page, reading it from the file if it is not cached.
+func (p *pager[T]) get(id pageID) (*pageNode[T], error) {
+	if e, ok := p.pages[id]; ok {
+		p.lru.MoveToFront(e)
+		return e.Value.(*pageNode[T]), nil
+	}
+	if id == 0 || id >= p.pageCount {
+		return nil, ErrCorruptedData
+	}
+	buf := make([]byte, p.pageSize)
+	if _, err := p.file.ReadAt(buf, int64(id) * int64(p.pageSize)); err != nil {
+		return nil, err
+	}
+	node, err := p.decode(id, buf)
+	if err != nil {
+		return nil, err
+	}
+	p.pages[id] = p.lru.PushFront(node)
+	return node, nil
+}
+
+func (p *pager[T]) decode(id pageID, buf []byte) (*pageNode[T], error) {
+	node := p.newNode(id, buf[0])
+	n := binary.BigEndian.Uint32(buf[1:pageHeaderSize])
+	if node.kind == pageFree {
+		node.next = pageID(n)
+		return node, nil
+	}
+	if node.kind > pageFree || n > uint32(2 * p.t - 1) {
+		return nil, ErrCorruptedData
+	}
+	node.n = int(n)
+	offset := pageHeaderSize
+	if !node.isLeaf() {
+		for i := 0; i <= node.n; i ++ {
+			node.children[i] = pageID(binary.BigEndian.Uint32(buf[offset:]))
+			offset += 4
+		}
+	}
+	r := bytes.NewReader(buf[offset:])
+	for i := 0; i < node.n; i ++ {
+		key, err := p.codec.Decode(r)
+		if err != nil {
+			return nil, err
+		}
+		node.keys[i] = key
+	}
+	return node, nil
+}
+
+func (p *pager[T]) encode(node *pageNode[T]) ([]byte, error) {
+	buf := bytes.NewBuffer(make([]byte, 0, p.pageSize))
+	buf.WriteByte(node.kind)
+	n := uint32(node.n)
+	if node.kind == pageFree {
+		n = uint32(node.next)
+	}
+	_ = binary.Write(buf, binary.BigEndian, n)
+	if node.kind == pageInternal {
+		_ = binary.Write(buf, binary.BigEndian, node.children[:node.n + 1])
+	}
+	if node.kind != pageFree {
+		for i := 0; i < node.n; i ++ {
+			if err := p.codec.Encode(buf, node.keys[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if buf.Len() > p.pageSize {
+		return nil, ErrPageOverflow
+	}
+	return buf.Bytes()[:p.pageSize], nil
+}
+
+// the accessors of the pages for bTreeAlgorithms; see bTreeNodes.
+
+func (p *pager[T]) isLeaf(node *pageNode[T]) bool {
+	return node.isLeaf()
+}
+
+func (p *pager[T]) num(node *pageNode[T]) int {
+	return node.n
+}
+
+func (p *pager[T]) setNum(node *pageNode[T], n int) {
+	node.n = n
+}
+
+func (p *pager[T]) keys(node *pageNode[T]) []T {
+	return node.keys
+}
+
+func (p *pager[T]) children(node *pageNode[T]) []pageID {
+	return node.children
+}
+
+func (p *pager[T]) ref(node *pageNode[T]) pageID {
+	return node.id
+}
+
+func (p *pager[T]) load(id pageID) (*pageNode[T], error) {
+	return p.get(id)
+}
+
+func (p *pager[T]) adopt(*pageNode[T], int) {}
+
+// markDirty marks the page to be written back by commit.
+func (p *pager[T]) markDirty(node *pageNode[T]) {
+	if !node.dirty {
+		node.dirty = true
+		p.dirtyPages = append(p.dirtyPages, node)
+	}
+}
+
+// allocate returns a new page, reusing a free page if there is one.
+func (p *pager[T]) allocate(isLeaf bool) (*pageNode[T], error) {
+	kind := pageInternal
+	if isLeaf {
+		kind = pageLeaf
+	}
+	var node *pageNode[T]
+	if p.freeHead != 0 {
+		var err error
+		if node, err = p.get(p.freeHead); err != nil {
+			return nil, err
+		}
+		p.freeHead = node.next
+		node.kind, node.n, node.next = kind, 0, 0
+	} else {
+		node = p.newNode(p.pageCount, kind)
+		p.pageCount ++
+		p.pages[node.id] = p.lru.PushFront(node)
+	}
+	p.markDirty(node)
+	return node, nil
+}
+
+// free puts the page into the free list.
+func (p *pager[T]) free(node *pageNode[T]) {
+	node.kind, node.n, node.next = pageFree, 0, p.freeHead
+	p.freeHead = node.id
+	p.markDirty(node)
+}
+
+// encodePages encodes the dirty pages; it is called before anything is written, so an operation rejected by the
+// codec or ErrPageOverflow leaves the file untouched and can be rolled back.
+func (p *pager[T]) encodePages() ([][]byte, error) {
+	pages := make([][]byte, len(p.dirtyPages))
+	for i, node := range p.dirtyPages {
+		page, err := p.encode(node)
+		if err != nil {
+			return nil, err
+		}
+		pages[i] = page
+	}
+	return pages, nil
+}
+
+// commit writes the encoded dirty pages & the header back to the file, and then trims the cache.
+func (p *pager[T]) commit(pages [][]byte, root pageID, num int) error {
+	if len(p.dirtyPages) == 0 && root == p.lastRoot && num == p.lastNum {
+		p.trim()
+		return nil
+	}
+	header := make([]byte, pagedBTreeHeaderSize)
+	copy(header, pagedBTreeMagic)
+	binary.BigEndian.PutUint32(header[4:], uint32(p.pageSize))
+	binary.BigEndian.PutUint32(header[8:], uint32(p.t))
+	binary.BigEndian.PutUint32(header[12:], uint32(root))
+	binary.BigEndian.PutUint32(header[16:], uint32(p.pageCount))
+	binary.BigEndian.PutUint32(header[20:], uint32(p.freeHead))
+	binary.BigEndian.PutUint64(header[24:], uint64(num))
+
+	if err := p.writeJournal(); err != nil {
+		return err
+	}
+	for i, node := range p.dirtyPages {
+		if _, err := p.file.WriteAt(pages[i], int64(node.id) * int64(p.pageSize)); err != nil {
+			return err
+		}
+		node.dirty = false
+	}
+	p.dirtyPages = p.dirtyPages[:0]
+	if _, err := p.file.WriteAt(header, 0); err != nil {
+		return err
+	}
+	if err := p.file.Sync(); err != nil {  // the journal must not be dropped before the pages are stored
+		return err
+	}
+	if err := p.journal.Truncate(0); err != nil {
+		return err
+	}
+	p.lastCount, p.lastFreeHead, p.lastRoot, p.lastNum = p.pageCount, p.freeHead, root, num
+	p.trim()
+	return nil
+}
+
+// writeJournal saves the committed content of the header & the dirty pages in the journal, and syncs it.
+//
+// The journal starts with the crc32 of the rest, the magic, the page size, the number of pages and the size of
+// the file at the last commit, followed by the pages, each of which is its id (4 bytes) & content.
+// The pages allocated after the last commit are not saved, as they are dropped by truncating the file.
+func (p *pager[T]) writeJournal() error {
+	ids := make([]pageID, 0, len(p.dirtyPages) + 1)
+	if p.lastCount > 0 {
+		ids = append(ids, 0)
+	}
+	for _, node := range p.dirtyPages {
+		if node.id < p.lastCount {
+			ids = append(ids, node.id)
+		}
+	}
+	buf := make([]byte, journalHeaderSize + len(ids) * (4 + p.pageSize))
+	copy(buf[4:], pagedBTreeJournalMagic)
+	binary.BigEndian.PutUint32(buf[8:], uint32(p.pageSize))
+	binary.BigEndian.PutUint32(buf[12:], uint32(len(ids)))
+	binary.BigEndian.PutUint64(buf[16:], uint64(p.lastCount) * uint64(p.pageSize))
+	offset := journalHeaderSize
+	for _, id := range ids {
+		binary.BigEndian.PutUint32(buf[offset:], uint32(id))
+		if _, err := p.file.ReadAt(buf[offset + 4:offset + 4 + p.pageSize], int64(id) * int64(p.pageSize)); err != nil {
+			return err
+		}
+		offset += 4 + p.pageSize
+	}
+	binary.BigEndian.PutUint32(buf, crc32.ChecksumIEEE(buf[4:]))
+	if _, err := p.journal.WriteAt(buf, 0); err != nil {
+		return err
+	}
+	return p.journal.Sync()
+}
+
+// rollback drops the dirty pages from the cache and restores the state of the last commit, which is returned as
+// the root & the number of elements.
+func (p *pager[T]) rollback() (pageID, int) {
+	for _, node := range p.dirtyPages {
+		if e, ok := p.pages[node.id]; ok {
+			delete(p.pages, node.id)
+			p.lru.Remove(e)
+		}
+		node.dirty = false
+	}
+	p.dirtyPages = p.dirtyPages[:0]
+	p.pageCount, p.freeHead = p.lastCount, p.lastFreeHead
+	p.trim()
+	return p.lastRoot, p.lastNum
+}
+
+// replayJournal restores the pages saved in the journal, which rolls an interrupted commit back, and then empties
+// the journal.
+//
+// A journal which is incomplete, e.g., the crash happens while writing it, is ignored, as the file has not been
+// touched by that commit yet.
+func replayJournal(file *os.File, journal *os.File) error {
+	info, err := journal.Stat()
+	if err != nil || info.Size() == 0 {
+		return err
+	}
+	buf := make([]byte, info.Size())
+	if _, err := journal.ReadAt(buf, 0); err != nil {
+		return err
+	}
+	if len(buf) >= journalHeaderSize && string(buf[4:8]) == pagedBTreeJournalMagic &&
+		crc32.ChecksumIEEE(buf[4:]) == binary.BigEndian.Uint32(buf) {
+		pageSize := int(binary.BigEndian.Uint32(buf[8:]))
+		count := int(binary.BigEndian.Uint32(buf[12:]))
+		if len(buf) != journalHeaderSize + count * (4 + pageSize) {
+			return ErrCorruptedData
+		}
+		for offset := journalHeaderSize; offset < len(buf); offset += 4 + pageSize {
+			id := int64(binary.BigEndian.Uint32(buf[offset:]))
+			if _, err := file.WriteAt(buf[offset + 4:offset + 4 + pageSize], id * int64(pageSize)); err != nil {
+				return err
+			}
+		}
+		if err := file.Truncate(int64(binary.BigEndian.Uint64(buf[16:]))); err != nil {
+			return err
+		}
+		if err := file.Sync(); err != nil {
+			return err
+		}
+	}
+	if err := journal.Truncate(0); err != nil {
+		return err
+	}
+	return journal.Sync()
+}
+
+// trim evicts the least recently used pages until the cache fits in its capacity; the pages must not be dirty.
+func (p *pager[T]) trim() {
+	for p.lru.Len() > p.capacity {
+		e := p.lru.Back()
+		delete(p.pages, e.Value.(*pageNode[T]).id)
+		p.lru.Remove(e)
+	}
+}
+
+// PagedBTree
+//
+// The disk-backed B-tree structure. Please use OpenPagedBTree() as the safe constructor, and call Close() when
+// the tree is no longer used.
+//
+// Every node is stored as a fixed-size page in a file, and children are referred to by page ids instead of pointers.
+// An LRU cache of pages sits in front of the file, so only the recently used nodes are kept in memory;
+// Insert, Delete and Search read the pages on demand, and every modification is written back to the file before
+// the method returns. The insertion & deletion algorithms are shared with BTree.
+//
+// The original content of the modified pages is saved in a journal file next to the file (path + "-journal")
+// before they are overwritten, so a crash in the middle of writing never leaves a half-modified tree; the
+// interrupted modification is rolled back when the file is opened again.
+//
+// .
+//
+// t int
+//
+//		The minimum degree; see BTree for details. A page must be large enough to hold 2*t - 1 encoded keys
+//		and 2*t children; otherwise ErrPageOverflow is returned by Insert.
+//
+// .
+//
+// compare is the function for comparing different node values;
+// it should return 1 if a > b , 0 if a == b, -1 if a < b
+//
+// .
+//
+// The values are encoded by the codec; see Codec for details. If a modification fails before anything is written,
+// e.g., it is rejected by the codec or ErrPageOverflow, it is rolled back and the tree keeps working. If writing the
+// file fails, the tree stops working and every method returns the same error afterwards; please reopen the file.
+type PagedBTree[T any] struct {
+	t int
+	num int
+	root pageID
+	compare func(a, b T) int
+	pager *pager[T]
+	err error  // the first fatal error; the tree stops working once it is set
+}
+
+// T returns the minimum degree
+func (pbt *PagedBTree[T]) T() int {
+	return pbt.t
+}
+
+// NumOfElements returns the number of elements in the PagedBTree
+func (pbt *PagedBTree[T]) NumOfElements() int {
+	return pbt.num
+}
+
+// commit writes the modification back to the file, or rolls it back if it can not be encoded.
+func (pbt *PagedBTree[T]) commit() error {
+	pages, err := pbt.pager.encodePages()
+	if err != nil {
+		pbt.rollback()
+		return err
+	}
+	if err := pbt.pager.commit(pages, pbt.root, pbt.num); err != nil {
+		pbt.err = err  // the file may be half-written, so the tree stops working
+		return err
+	}
+	return nil
+}
+
+// rollback drops the modification which has not been written, and restores the last committed root & num.
+func (pbt *PagedBTree[T]) rollback() {
+	pbt.root, pbt.num = pbt.pager.rollback()
+}
+
+func (pbt *PagedBTree[T]) algorithms() bTreeAlgorithms[T, *pageNode[T], pageID] {
+	return bTreeAlgorithms[T, *pageNode[T], pageID]{t: pbt.t, compare: pbt.compare, nodes: pbt.pager}
+}
+
+// Search searches for an element in the PagedBTree.
+//
+// Returns the first corresponding value, and a boolean indicating whether the searching is successful.
+func (pbt *PagedBTree[T]) Search(val T) (r T, b bool, err error) {
+	if pbt.err != nil {
+		return r, false, pbt.err
+	}
+	defer pbt.pager.trim()
+
+	cur, err := pbt.pager.get(pbt.root)
+	for err == nil {
+		i := 0
+		for i < cur.n && pbt.compare(cur.keys[i], val) == -1 {
+			i ++
+		}
+
+		if i < cur.n && pbt.compare(cur.keys[i], val) == 0 {
+			return cur.keys[i], true, nil
+		} else if cur.isLeaf() {
+			return r, false, nil
+		} else {
+			cur, err = pbt.pager.get(cur.children[i])
+		}
+	}
+	return r, false, err
+}
+
+// Insert inserts a new value into the b-tree.
+//
+// It returns ErrPageOverflow if the value does not fit in a page, and the tree is left unchanged.
+func (pbt *PagedBTree[T]) Insert(val T) error {
+	if pbt.err != nil {
+		return pbt.err
+	}
+
+	root, err := pbt.pager.get(pbt.root)
+	if err == nil {
+		root, err = pbt.algorithms().insert(root, val)
+	}
+	if err != nil {
+		pbt.rollback()
+		return err
+	}
+	pbt.root = root.id
+	pbt.num ++
+	return pbt.commit()
+}
+
+// Delete deletes the first corresponding value, and returns a boolean indicating whether the deletion is successful.
+func (pbt *PagedBTree[T]) Delete(val T) (bool, error) {
+	if pbt.err != nil {
+		return false, pbt.err
+	}
+
+	root, err := pbt.pager.get(pbt.root)
+	b := false
+	if err == nil {
+		root, b, err = pbt.algorithms().delete(root, val)
+	}
+	if err != nil {
+		pbt.rollback()
+		return false, err
+	}
+	pbt.root = root.id
+	if b {
+		pbt.num --
+	}
+
+	if err := pbt.commit(); err != nil {
+		return false, err
+	}
+	return b, nil
+}
+
+// Values returns all the values in the tree in an ordered manner.
+//
+// Note: it reads every page of the tree and is expensive.
+func (pbt *PagedBTree[T]) Values() ([]T, error) {
+	if pbt.err != nil {
+		return nil, pbt.err
+	}
+	defer pbt.pager.trim()
+
+	r := make([]T, 0, pbt.num)
+	var dfs func(id pageID) error
+	dfs = func(id pageID) error {
+		node, err := pbt.pager.get(id)
+		if err != nil {
+			return err
+		}
+		for i := 0; i < node.n; i ++ {
+			if !node.isLeaf() {
+				if err := dfs(node.children[i]); err != nil {
+					return err
+				}
+			}
+			r = append(r, node.keys[i])
+		}
+		if !node.isLeaf() {
+			return dfs(node.children[node.n])
+		}
+		return nil
+	}
+	if err := dfs(pbt.root); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Sync commits the content of the file to stable storage.
+//
+// The pages are synced by every modification, so it only makes sure that the last modification is not rolled back
+// by the journal after a crash.
+func (pbt *PagedBTree[T]) Sync() error {
+	if pbt.err != nil {
+		return pbt.err
+	}
+	return pbt.pager.journal.Sync()
+}
+
+// Close syncs and closes the file; the tree can not be used afterwards.
+func (pbt *PagedBTree[T]) Close() error {
+	err := pbt.Sync()
+	if cerr := pbt.pager.file.Close(); err == nil {
+		err = cerr
+	}
+	if cerr := pbt.pager.journal.Close(); err == nil {
+		err = cerr
+	}
+	if pbt.err == nil {
+		pbt.err = os.ErrClosed
+	}
+	return err
+}
+
+// OpenPagedBTree opens the PagedBTree stored in the file, or creates a new one if the file is empty or does not exist.
+//
+// If the journal (path + "-journal") is left by a crash, the interrupted modification is rolled back first.
+//
+// t & pageSize are only used for a new file; an existing file keeps its own minimum degree & page size.
+// cacheSize is the number of pages kept in memory.
+//
+// t must > 1, and cacheSize must > 0; otherwise it returns an error.
+func OpenPagedBTree[T any](path string, t int, pageSize int, cacheSize int, compare func(a, b T) int,
+	codec Codec[T]) (*PagedBTree[T], error) {
+	if t < 2 {
+		return nil, fmt.Errorf("the minimum degree t must be > 1")
+	}
+	if cacheSize < 1 {
+		return nil, fmt.Errorf("the cache size must be > 0")
+	}
+	if codec == nil {
+		return nil, ErrNoCodec
+	}
+	file, err := os.OpenFile(path, os.O_RDWR | os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	journal, err := os.OpenFile(path + "-journal", os.O_RDWR | os.O_CREATE, 0644)
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	pbt, err := openPagedBTree(file, journal, t, pageSize, cacheSize, compare, codec)
+	if err != nil {
+		_ = file.Close()
+		_ = journal.Close()
+		return nil, err
+	}
+	return pbt, nil
+}
+
+func openPagedBTree[T any](file *os.File, journal *os.File, t int, pageSize int, cacheSize int,
+	compare func(a, b T) int, codec Codec[T]) (*PagedBTree[T], error) {
+	if err := replayJournal(file, journal); err != nil {
+		return nil, err
+	}
+	p := &pager[T]{file: file, journal: journal, capacity: cacheSize, codec: codec,
+		pages: make(map[pageID]*list.Element), lru: list.New()}
+	pbt := &PagedBTree[T]{compare: compare, pager: p}
+
+	header := make([]byte, pagedBTreeHeaderSize)
+	n, err := file.ReadAt(header, 0)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	if n == 0 {  // a new file
+		if pageSize < pagedBTreeHeaderSize || pageSize < pageHeaderSize + 4 * 2 * t {
+			return nil, fmt.Errorf("the page size %d is too small for t = %d", pageSize, t)
+		}
+		p.pageSize, p.t, p.pageCount = pageSize, t, 1
+		root, err := p.allocate(true)
+		if err != nil {
+			return nil, err
+		}
+		pbt.t, pbt.root = t, root.id
+		if err := pbt.commit(); err != nil {
+			return nil, err
+		}
+		return pbt, nil
+	}
+
+	if n < pagedBTreeHeaderSize || string(header[:4]) != pagedBTreeMagic {
+		return nil, ErrCorruptedData
+	}
+	p.pageSize = int(binary.BigEndian.Uint32(header[4:]))
+	p.t = int(binary.BigEndian.Uint32(header[8:]))
+	pbt.root = pageID(binary.BigEndian.Uint32(header[12:]))
+	p.pageCount = pageID(binary.BigEndian.Uint32(header[16:]))
+	p.freeHead = pageID(binary.BigEndian.Uint32(header[20:]))
+	pbt.num = int(binary.BigEndian.Uint64(header[24:]))
+	pbt.t = p.t
+	p.lastCount, p.lastFreeHead, p.lastRoot, p.lastNum = p.pageCount, p.freeHead, pbt.root, pbt.num
+	if p.t < 2 || p.pageSize < pagedBTreeHeaderSize || p.pageSize < pageHeaderSize + 4 * 2 * p.t ||
+		pbt.root == 0 || pbt.root >= p.pageCount || p.freeHead >= p.pageCount {
+		return nil, ErrCorruptedData
+	}
+	return pbt, nil
+}
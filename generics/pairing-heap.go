@@ -0,0 +1,198 @@
+package generics
+
+import (
+	"errors"
+)
+
+// PairingHeap
+//
+// The pairing heap structure, which is an ordered min-heap. Please use NewPairingHeap() as the safe constructor.
+//
+// It has the same API as FibonacciHeap, and is usually faster in practice: Insert, Union and DecreaseKey cost O(1)
+// (DecreaseKey is amortized o(log n)), and ExtractMin & Delete cost amortized O(log n).
+//
+// .
+//
+// compare is the function for comparing different node values;
+// it should return 1 if a > b , 0 if a == b, -1 if a < b.
+type PairingHeap[T any] struct {
+	n int
+	root *PairingNode[T]
+	compare func(a, b T) int
+}
+
+// NumOfElements returns the number of nodes in the heap.
+func (ph *PairingHeap[T]) NumOfElements() int {
+	return ph.n
+}
+
+// melds 2 trees and returns the new root; the larger root becomes the leftmost child of the smaller one.
+func (ph *PairingHeap[T]) meld(a, b *PairingNode[T]) *PairingNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if ph.compare(a.Val, b.Val) == 1 {
+		a, b = b, a
+	}
+	b.prev = a
+	b.next = a.child
+	if a.child != nil {
+		a.child.prev = b
+	}
+	a.child = b
+	return a
+}
+
+// melds the sibling list starting from first with the two-pass pairing, and returns the new root.
+func (ph *PairingHeap[T]) mergePairs(first *PairingNode[T]) *PairingNode[T] {
+	// first pass: melds the siblings in pairs from left to right
+	pairs := make([]*PairingNode[T], 0)
+	for first != nil {
+		a, b := first, first.next
+		first = nil
+		if b != nil {
+			first = b.next
+			b.prev, b.next = nil, nil
+		}
+		a.prev, a.next = nil, nil
+		pairs = append(pairs, ph.meld(a, b))
+	}
+	// second pass: melds the pairs from right to left
+	var root *PairingNode[T]
+	for i := len(pairs) - 1; i >= 0; i -- {
+		root = ph.meld(pairs[i], root)
+	}
+	return root
+}
+
+// detaches the subtree rooted at node from its parent & siblings; node must not be the root.
+func (ph *PairingHeap[T]) detach(node *PairingNode[T]) {
+	if node.prev.child == node {  // the leftmost child
+		node.prev.child = node.next
+	} else {
+		node.prev.next = node.next
+	}
+	if node.next != nil {
+		node.next.prev = node.prev
+	}
+	node.prev, node.next = nil, nil
+}
+
+// returns true if the node is in the heap; only the root has no prev node, and extracted or deleted nodes are
+// detached from the heap.
+//
+// The node must come from this heap: a node of another heap is not detected, as recording the owner in every node
+// would make Union O(n).
+func (ph *PairingHeap[T]) contains(node *PairingNode[T]) bool {
+	return node != nil && (node == ph.root || node.prev != nil)
+}
+
+// Insert inserts a new val into the heap and returns a pointer to the inserted node.
+func (ph *PairingHeap[T]) Insert(val T) *PairingNode[T] {
+	node := &PairingNode[T]{Val: val}
+	ph.root = ph.meld(ph.root, node)
+	ph.n ++
+	return node
+}
+
+// Minimum returns the min node of the heap; it won't change the heap.
+func (ph *PairingHeap[T]) Minimum() *PairingNode[T] {
+	return ph.root
+}
+
+// ExtractMin pops out the minimum node of the heap; it will change the heap.
+func (ph *PairingHeap[T]) ExtractMin() *PairingNode[T] {
+	z := ph.root
+	if z != nil {
+		ph.root = ph.mergePairs(z.child)
+		z.child = nil
+		ph.n --
+	}
+	return z
+}
+
+// DecreaseKey decrease the val of a node to newVal.
+//
+// The node must be returned by Insert of this heap, or of a heap merged into it by Union; passing a node of another
+// heap corrupts both heaps.
+func (ph *PairingHeap[T]) DecreaseKey(node *PairingNode[T], newVal T) error {
+	if !ph.contains(node) {
+		return errors.New(errorNoElement)
+	}
+	if ph.compare(newVal, node.Val) == 1 {
+		return errors.New("new key cannot be greater than the old key")
+	}
+	node.Val = newVal
+	if node != ph.root {
+		ph.detach(node)
+		ph.root = ph.meld(ph.root, node)
+	}
+	return nil
+}
+
+// Delete deletes a node from the heap.
+//
+// It returns an error if the node is not in the heap, e.g., it has been extracted or deleted. As with DecreaseKey,
+// the node must come from this heap; passing a node of another heap corrupts both heaps.
+func (ph *PairingHeap[T]) Delete(node *PairingNode[T]) error {
+	if !ph.contains(node) {
+		return errors.New(errorNoElement)
+	}
+	if node == ph.root {
+		ph.ExtractMin()
+		return nil
+	}
+	ph.detach(node)
+	ph.root = ph.meld(ph.root, ph.mergePairs(node.child))
+	node.child = nil
+	ph.n --
+	return nil
+}
+
+// Union returns the union of the 2 pairing heaps in O(1).
+//
+// The nodes are moved into the new heap, so the pointers returned by Insert still work on the new heap;
+// both old heaps become empty.
+func (ph *PairingHeap[T]) Union(other *PairingHeap[T]) *PairingHeap[T] {
+	h := NewPairingHeap(ph.compare)
+	h.root, h.n = ph.root, ph.n
+	if other != ph {
+		h.root = h.meld(h.root, other.root)
+		h.n += other.n
+	}
+	ph.root, ph.n = nil, 0
+	other.root, other.n = nil, 0
+	return h
+}
+
+// Len returns the number of nodes in the heap.
+func (ph *PairingHeap[T]) Len() int {
+	return ph.n
+}
+
+// IsEmpty returns true if the heap has no nodes.
+func (ph *PairingHeap[T]) IsEmpty() bool {
+	return ph.n == 0
+}
+
+// Values returns all the values in the heap; the order is not specified.
+func (ph *PairingHeap[T]) Values() []T {
+	r := make([]T, 0, ph.n)
+	var walk func(node *PairingNode[T])
+	walk = func(node *PairingNode[T]) {
+		for ; node != nil; node = node.next {
+			r = append(r, node.Val)
+			walk(node.child)
+		}
+	}
+	walk(ph.root)
+	return r
+}
+
+// NewPairingHeap returns an empty PairingHeap object.
+func NewPairingHeap[T any](compare func(a, b T) int) *PairingHeap[T] {
+	return &PairingHeap[T]{compare: compare}
+}
@@ -0,0 +1,290 @@
+package generics
+
+// the node of PersistentRedBlackTree; it must never be modified once it is shared by a version of the tree.
+type persistentNode[T any] struct {
+	Val T
+	Color bool
+	Left *persistentNode[T]
+	Right *persistentNode[T]
+}
+
+// returns a shallow copy of the node, which can be modified freely.
+func (n *persistentNode[T]) clone() *persistentNode[T] {
+	tmp := *n
+	return &tmp
+}
+
+// PersistentRedBlackTree
+//
+// The persistent (immutable) red-black tree. Please use NewPersistentRedBlackTree() as the safe constructor, which
+// returns an empty version of the tree.
+//
+// Insert and Delete never change the current version; they return a new version instead, which copies the nodes
+// on the modified path and shares all the other subtrees with the current version. Therefore, every old version stays
+// readable and unchanged, and each operation only costs O(log n) time and memory.
+//
+// It is a left-leaning red-black tree, so the shape is different from RedBlackTree.
+//
+// .
+//
+// compare is the function for comparing different node values;
+// it should return 1 if a > b , 0 if a == b, -1 if a < b.
+//
+// .
+//
+// Both inputs of the compare function are of type T. When T is interface{}, the second input may have variant types,
+// and a tricky compare method can relax the conditions for Search and Delete; see examples for details.
+type PersistentRedBlackTree[T any] struct {
+	root *persistentNode[T]
+	size int
+	compare func(a, b T) int
+}
+
+func isRedNode[T any](n *persistentNode[T]) bool {
+	return n != nil && n.Color == red
+}
+
+// left-rotates the subtree; h must be a new copy.
+func (prbt *PersistentRedBlackTree[T]) rotateLeft(h *persistentNode[T]) *persistentNode[T] {
+	x := h.Right.clone()
+	h.Right = x.Left
+	x.Left = h
+	x.Color = h.Color
+	h.Color = red
+	return x
+}
+
+// right-rotates the subtree; h must be a new copy.
+func (prbt *PersistentRedBlackTree[T]) rotateRight(h *persistentNode[T]) *persistentNode[T] {
+	x := h.Left.clone()
+	h.Left = x.Right
+	x.Right = h
+	x.Color = h.Color
+	h.Color = red
+	return x
+}
+
+// flips the colors of the node and its children; h must be a new copy.
+func (prbt *PersistentRedBlackTree[T]) flipColors(h *persistentNode[T]) {
+	h.Color = !h.Color
+	if h.Left != nil {
+		h.Left = h.Left.clone()
+		h.Left.Color = !h.Left.Color
+	}
+	if h.Right != nil {
+		h.Right = h.Right.clone()
+		h.Right.Color = !h.Right.Color
+	}
+}
+
+// restores the left-leaning red-black tree property on the way up; h must be a new copy.
+func (prbt *PersistentRedBlackTree[T]) balance(h *persistentNode[T]) *persistentNode[T] {
+	if isRedNode(h.Right) && !isRedNode(h.Left) {
+		h = prbt.rotateLeft(h)
+	}
+	if isRedNode(h.Left) && isRedNode(h.Left.Left) {
+		h = prbt.rotateRight(h)
+	}
+	if isRedNode(h.Left) && isRedNode(h.Right) {
+		prbt.flipColors(h)
+	}
+	return h
+}
+
+// makes h.Left or one of its children red; h must be a new copy.
+func (prbt *PersistentRedBlackTree[T]) moveRedLeft(h *persistentNode[T]) *persistentNode[T] {
+	prbt.flipColors(h)
+	if isRedNode(h.Right.Left) {
+		h.Right = prbt.rotateRight(h.Right)
+		h = prbt.rotateLeft(h)
+		prbt.flipColors(h)
+	}
+	return h
+}
+
+// makes h.Right or one of its children red; h must be a new copy.
+func (prbt *PersistentRedBlackTree[T]) moveRedRight(h *persistentNode[T]) *persistentNode[T] {
+	prbt.flipColors(h)
+	if isRedNode(h.Left.Left) {
+		h = prbt.rotateRight(h)
+		prbt.flipColors(h)
+	}
+	return h
+}
+
+// inserts val into the subtree and returns the new copy of the subtree.
+func (prbt *PersistentRedBlackTree[T]) insert(h *persistentNode[T], val T) *persistentNode[T] {
+	if h == nil {
+		return &persistentNode[T]{Val: val, Color: red}
+	}
+	h = h.clone()
+	if prbt.compare(h.Val, val) == 1 {  // h.Val > val
+		h.Left = prbt.insert(h.Left, val)
+	} else {
+		h.Right = prbt.insert(h.Right, val)
+	}
+	return prbt.balance(h)
+}
+
+// deletes the minimum node of the subtree and returns the new copy of the subtree.
+func (prbt *PersistentRedBlackTree[T]) deleteMin(h *persistentNode[T]) *persistentNode[T] {
+	if h.Left == nil {
+		return nil
+	}
+	h = h.clone()
+	if !isRedNode(h.Left) && !isRedNode(h.Left.Left) {
+		h = prbt.moveRedLeft(h)
+	}
+	h.Left = prbt.deleteMin(h.Left)
+	return prbt.balance(h)
+}
+
+// deletes a node with the corresponding value from the subtree and returns the new copy of the subtree.
+//
+// The value must exist in the subtree.
+func (prbt *PersistentRedBlackTree[T]) delete(h *persistentNode[T], val T) *persistentNode[T] {
+	h = h.clone()
+	if prbt.compare(h.Val, val) == 1 {  // h.Val > val
+		if !isRedNode(h.Left) && !isRedNode(h.Left.Left) {
+			h = prbt.moveRedLeft(h)
+		}
+		h.Left = prbt.delete(h.Left, val)
+	} else {
+		if isRedNode(h.Left) {
+			h = prbt.rotateRight(h)
+		}
+		if prbt.compare(h.Val, val) == 0 && h.Right == nil {
+			return nil
+		}
+		equal := prbt.compare(h.Val, val) == 0
+		if !isRedNode(h.Right) && !isRedNode(h.Right.Left) {
+			old := h
+			h = prbt.moveRedRight(h)
+			equal = equal && h == old  // a rotation moves the corresponding node down to h.Right
+		}
+		if equal {  // replace h with its successor
+			successor := h.Right
+			for successor.Left != nil {
+				successor = successor.Left
+			}
+			h.Val = successor.Val
+			h.Right = prbt.deleteMin(h.Right)
+		} else {
+			h.Right = prbt.delete(h.Right, val)
+		}
+	}
+	return prbt.balance(h)
+}
+
+// returns a new version with the new root.
+func (prbt *PersistentRedBlackTree[T]) withRoot(root *persistentNode[T], size int) *PersistentRedBlackTree[T] {
+	if root != nil {
+		root.Color = black  // the root is always a new copy
+	}
+	return &PersistentRedBlackTree[T]{root: root, size: size, compare: prbt.compare}
+}
+
+// Insert returns a new version with val inserted, and a boolean indicating if the insertion happens.
+//
+// Does not insert if the val already exists in the tree; in that case it returns the current version itself.
+func (prbt *PersistentRedBlackTree[T]) Insert(val T) (*PersistentRedBlackTree[T], bool) {
+	if _, b := prbt.Search(val); b {
+		return prbt, false
+	}
+	return prbt.UnsafeInsert(val), true
+}
+
+// UnsafeInsert returns a new version with val inserted, and allows the same val to be inserted for multiple times.
+func (prbt *PersistentRedBlackTree[T]) UnsafeInsert(val T) *PersistentRedBlackTree[T] {
+	return prbt.withRoot(prbt.insert(prbt.root, val), prbt.size + 1)
+}
+
+// Delete returns a new version without the corresponding value, and a boolean indicating if the deletion is successful.
+//
+// If there are multiple corresponding values, only one of them is deleted. If there is no such value,
+// it returns the current version itself.
+func (prbt *PersistentRedBlackTree[T]) Delete(val T) (*PersistentRedBlackTree[T], bool) {
+	if _, b := prbt.Search(val); !b {
+		return prbt, false
+	}
+	root := prbt.root.clone()
+	if !isRedNode(root.Left) && !isRedNode(root.Right) {
+		root.Color = red
+	}
+	return prbt.withRoot(prbt.delete(root, val), prbt.size - 1), true
+}
+
+// Search returns the value of the FIRST corresponding node if that node exists in the tree.
+func (prbt *PersistentRedBlackTree[T]) Search(val T) (T, bool) {
+	cur := prbt.root
+	for cur != nil {
+		c := prbt.compare(cur.Val, val)
+		if c == 0 {
+			return cur.Val, true
+		} else if c == 1 {  // cur.Val > val
+			cur = cur.Left
+		} else {
+			cur = cur.Right
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// InOrderTreeWalk returns all the values of the tree in an in-order-tree-walk manner.
+func (prbt *PersistentRedBlackTree[T]) InOrderTreeWalk() []T {
+	r := make([]T, 0, prbt.size)
+	var inorder func(node *persistentNode[T])
+	inorder = func(node *persistentNode[T]) {
+		if node != nil {
+			inorder(node.Left)
+			r = append(r, node.Val)
+			inorder(node.Right)
+		}
+	}
+	inorder(prbt.root)
+	return r
+}
+
+// Size returns the number of nodes in the tree.
+func (prbt *PersistentRedBlackTree[T]) Size() int {
+	return prbt.size
+}
+
+// Len returns the number of nodes in the tree; same as Size.
+func (prbt *PersistentRedBlackTree[T]) Len() int {
+	return prbt.size
+}
+
+// IsEmpty returns true if the tree has no nodes.
+func (prbt *PersistentRedBlackTree[T]) IsEmpty() bool {
+	return prbt.size == 0
+}
+
+// Values returns all the values of the tree in ascending order; same as InOrderTreeWalk.
+func (prbt *PersistentRedBlackTree[T]) Values() []T {
+	return prbt.InOrderTreeWalk()
+}
+
+// Height returns the height of the tree.
+//
+// Warning: it uses dfs and is expensive.
+func (prbt *PersistentRedBlackTree[T]) Height() int {
+	var dfs func(cur *persistentNode[T]) int
+	dfs = func(cur *persistentNode[T]) int {
+		if cur == nil {
+			return 0
+		}
+		l, r := dfs(cur.Left), dfs(cur.Right)
+		if l > r {
+			return l + 1
+		}
+		return r + 1
+	}
+	return dfs(prbt.root)
+}
+
+// NewPersistentRedBlackTree returns an empty PersistentRedBlackTree object.
+func NewPersistentRedBlackTree[T any](compare func(a, b T) int) *PersistentRedBlackTree[T] {
+	return &PersistentRedBlackTree[T]{compare: compare}
+}
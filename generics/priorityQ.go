@@ -0,0 +1,200 @@
+package generics
+
+import (
+	"some-data-structures/common"
+)
+
+// PQItem
+//
+// The handle of a value in PriorityQ, returned by Push.
+//
+// Val T: the current value; please use PriorityQ.Update to change it.
+type PQItem[T any] struct {
+	Val T
+	seq uint64  // the order of pushing, for breaking ties
+	index int  // the position in the heap; -1 once the item is popped
+}
+
+// PriorityQ the priority queue
+//
+// It is a binary heap, so Push and Pop cost O(log n). Values with equal priorities are popped in the order
+// they are pushed.
+//
+// compare: the function for comparing a and b
+//
+// for ascending order, compare must return 1 if a > b
+//
+// for descending order, compare must return 1 if a < b
+type PriorityQ[T any] struct {
+	queue []*PQItem[T]
+	compare func(a, b T) int
+	seq uint64  // the sequence number of the next pushed value
+}
+
+func (pq *PriorityQ[T]) Len() int {
+	return len(pq.queue)
+}
+
+func (pq *PriorityQ[T]) HasNext() bool {
+	return len(pq.queue) != 0
+}
+
+// IsEmpty returns true if the queue has no values.
+func (pq *PriorityQ[T]) IsEmpty() bool {
+	return len(pq.queue) == 0
+}
+
+// Values returns the values in the queue; the order is not specified.
+func (pq *PriorityQ[T]) Values() []T {
+	r := make([]T, len(pq.queue))
+	for i, item := range pq.queue {
+		r[i] = item.Val
+	}
+	return r
+}
+
+func (pq *PriorityQ[T]) swap(i, j int) {
+	pq.queue[i], pq.queue[j] = pq.queue[j], pq.queue[i]
+	pq.queue[i].index = i
+	pq.queue[j].index = j
+}
+
+// returns true if queue[i] should be popped before queue[j].
+func (pq *PriorityQ[T]) before(i, j int) bool {
+	a, b := pq.queue[i], pq.queue[j]
+	if pq.compare(a.Val, b.Val) == 1 {
+		return false
+	}
+	return pq.compare(b.Val, a.Val) == 1 || a.seq < b.seq
+}
+
+func (pq *PriorityQ[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !pq.before(i, parent) {
+			return
+		}
+		pq.swap(i, parent)
+		i = parent
+	}
+}
+
+func (pq *PriorityQ[T]) down(i int) {
+	n := len(pq.queue)
+	for {
+		first := i
+		if left := 2 * i + 1; left < n && pq.before(left, first) {
+			first = left
+		}
+		if right := 2 * i + 2; right < n && pq.before(right, first) {
+			first = right
+		}
+		if first == i {
+			return
+		}
+		pq.swap(i, first)
+		i = first
+	}
+}
+
+// appends a new item without restoring the heap.
+func (pq *PriorityQ[T]) appendItem(v T) *PQItem[T] {
+	item := &PQItem[T]{Val: v, seq: pq.seq, index: len(pq.queue)}
+	pq.seq ++
+	pq.queue = append(pq.queue, item)
+	return item
+}
+
+// Push pushes v into the queue, and returns its handle for Update.
+func (pq *PriorityQ[T]) Push(v T) *PQItem[T] {
+	item := pq.appendItem(v)
+	pq.up(item.index)
+	return item
+}
+
+// PushAll pushes all the values in order.
+//
+// When many values are pushed at once, it rebuilds the heap in O(n) instead of pushing them one by one.
+func (pq *PriorityQ[T]) PushAll(values ...T) {
+	if len(values) < len(pq.queue) {
+		for _, v := range values {
+			pq.Push(v)
+		}
+		return
+	}
+	for _, v := range values {
+		pq.appendItem(v)
+	}
+	for i := len(pq.queue) / 2 - 1; i >= 0; i -- {
+		pq.down(i)
+	}
+}
+
+// Peek returns the next value without popping it.
+//
+// The queue must not be empty; please check HasNext first.
+func (pq *PriorityQ[T]) Peek() T {
+	return pq.queue[0].Val
+}
+
+// Pop pops the next value.
+//
+// The queue must not be empty; please check HasNext first.
+func (pq *PriorityQ[T]) Pop() T {
+	item := pq.queue[0]
+	last := len(pq.queue) - 1
+	pq.swap(0, last)
+	pq.queue[last] = nil  // so the popped item can be collected
+	pq.queue = pq.queue[:last]
+	pq.down(0)
+	item.index = -1
+	return item.Val
+}
+
+// Update changes the value of the item and moves it to the proper position, and returns false if the item is no
+// longer in the queue.
+//
+// The item keeps its original order among the values with equal priorities.
+func (pq *PriorityQ[T]) Update(item *PQItem[T], v T) bool {
+	if item == nil || item.index < 0 || item.index >= len(pq.queue) || pq.queue[item.index] != item {
+		return false
+	}
+	item.Val = v
+	pq.up(item.index)
+	pq.down(item.index)
+	return true
+}
+
+// Reset completely resets the queue
+//
+// Warning: it will empty the queue
+func (pq *PriorityQ[T]) Reset() {
+	for _, item := range pq.queue {
+		item.index = -1
+	}
+	pq.queue = make([]*PQItem[T], 0)
+}
+
+// Copy makes a deep copy
+//
+// The handles of the original queue do not work on the copy.
+func (pq *PriorityQ[T]) Copy() *PriorityQ[T] {
+	queue := make([]*PQItem[T], len(pq.queue))
+	for i, item := range pq.queue {
+		tmp := *item
+		tmp.Val = common.CopyAs(item.Val)
+		queue[i] = &tmp
+	}
+	return &PriorityQ[T]{queue: queue, compare: pq.compare, seq: pq.seq}
+}
+
+// NewPriorityQ creates a new priority queue PriorityQ
+//
+// compare: the function for comparing a and b
+//
+// for ascending order, compare must return 1 if a > b
+//
+// for descending order, compare must return 1 if a < b
+func NewPriorityQ[T any](compare func(a, b T) int) *PriorityQ[T] {
+	return &PriorityQ[T]{queue: make([]*PQItem[T], 0), compare: compare}
+}
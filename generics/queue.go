@@ -0,0 +1,60 @@
+package generics
+
+// Queue
+//
+// The FIFO queue structure. Please use NewQueue() as the safe constructor; the zero value is also an
+// empty queue.
+//
+// It is a thin wrapper over Deque, which pushes to the back and pops from the front.
+type Queue[T any] struct {
+	deque Deque[T]
+}
+
+func (q *Queue[T]) Len() int {
+	return q.deque.Len()
+}
+
+func (q *Queue[T]) HasNext() bool {
+	return q.deque.HasNext()
+}
+
+// IsEmpty returns true if the queue has no values.
+func (q *Queue[T]) IsEmpty() bool {
+	return q.deque.IsEmpty()
+}
+
+// Push pushes v into the queue.
+func (q *Queue[T]) Push(v T) {
+	q.deque.PushBack(v)
+}
+
+// Pop pops out and returns the first element of the queue.
+//
+// Please check if the queue is empty before using this method; it returns the zero value of T if the queue is empty.
+//
+// e.g.,
+//
+// if queue.HasNext() { queue.Pop() }
+func (q *Queue[T]) Pop() T {
+	v, _ := q.deque.PopFront()
+	return v
+}
+
+// Values returns the values in the queue, from the head to the tail.
+func (q *Queue[T]) Values() []T {
+	return q.deque.Values()
+}
+
+// Empty completely empties the queue.
+func (q *Queue[T]) Empty() {
+	q.deque.Clear()
+}
+
+// Copy makes a deep copy of the queue
+func (q *Queue[T]) Copy() *Queue[T] {
+	return &Queue[T]{deque: *q.deque.Copy()}
+}
+
+func NewQueue[T any]() *Queue[T] {
+	return &Queue[T]{deque: *NewDeque[T]()}
+}
@@ -0,0 +1,634 @@
+package generics
+
+const red = true
+const black = false
+
+// RedBlackTree
+//
+// The red-black tree structure. Please use NewRedBlackTree() as the safe constructor.
+//
+// Attributes:
+//
+// Root *RBTreeNode[T]
+//
+// sentinel *RBTreeNode[T]
+//
+// compare func(a, b T) int
+//
+// .
+//
+// compare is the function for comparing different node values;
+// it should return 1 if a > b , 0 if a == b, -1 if a < b.
+//
+// .
+//
+// Both inputs of the compare function are of type T. When T is interface{}, the second input may have variant types,
+// and a tricky compare method can relax the conditions for Search and Delete; see examples for details.
+//
+// .
+//
+// Note that this RedBlackTree does not perform type checking; please include any necessary type checking
+// in the customized compare function.
+type RedBlackTree[T any] struct {
+	Root *RBTreeNode[T]
+	sentinel *RBTreeNode[T]
+	compare  func(a, b T) int
+}
+
+// InOrderTreeWalk returns all the values of the tree in an in-order-tree-walk manner.
+func (rbt *RedBlackTree[T]) InOrderTreeWalk() []T {
+	r := make([]T, 0)
+	var inorder func(node *RBTreeNode[T])
+	inorder = func(node *RBTreeNode[T]) {
+		if node != nil && node != rbt.sentinel {
+			inorder(node.Left)
+			r = append(r, node.Val)
+			inorder(node.Right)
+		}
+	}
+	inorder(rbt.Root)
+	return r
+}
+
+// Len returns the number of nodes in the tree; same as Size.
+func (rbt *RedBlackTree[T]) Len() int {
+	return rbt.Size()
+}
+
+// IsEmpty returns true if the tree has no nodes.
+func (rbt *RedBlackTree[T]) IsEmpty() bool {
+	return rbt.Size() == 0
+}
+
+// Values returns all the values of the tree in ascending order; same as InOrderTreeWalk.
+func (rbt *RedBlackTree[T]) Values() []T {
+	return rbt.InOrderTreeWalk()
+}
+
+// Contains returns true if the val exists in the tree.
+func (rbt *RedBlackTree[T]) Contains(val T) bool {
+	_, b := rbt.Search(val)
+	return b
+}
+
+// Search returns the pointer to the FIRST corresponding RBTreeNode if that RBTreeNode exists in the tree.
+func (rbt *RedBlackTree[T]) Search(val T) (*RBTreeNode[T], bool) {
+	cur := rbt.Root
+	for {
+		if cur == nil || cur == rbt.sentinel {
+			break
+		}
+		c := rbt.compare(cur.Val, val)
+		if c == 0 {
+			return cur, true
+		} else if c == 1 {  // cur.Val > val
+			cur = cur.Left
+		} else {
+			cur = cur.Right
+		}
+	}
+	return nil, false
+}
+
+// MaxSince returns the pointer to the max (rightmost) RBTreeNode in the subtree since the current node.
+func (rbt *RedBlackTree[T]) MaxSince(node *RBTreeNode[T]) *RBTreeNode[T] {
+	cur := node
+	for {
+		if cur == nil || cur == rbt.sentinel || cur.Right == rbt.sentinel {
+			break
+		}
+		cur = cur.Right
+	}
+	return cur
+}
+
+// MinSince returns the pointer to the min (leftmost) RBTreeNode in the subtree since the current node.
+func (rbt *RedBlackTree[T]) MinSince(node *RBTreeNode[T]) *RBTreeNode[T] {
+	cur := node
+	for {
+		if cur == nil || cur == rbt.sentinel || cur.Left == rbt.sentinel {
+			break
+		}
+		cur = cur.Left
+	}
+	return cur
+}
+
+// Max returns the pointer to the max (rightmost) RBTreeNode in the tree.
+func (rbt *RedBlackTree[T]) Max() *RBTreeNode[T] {
+	return rbt.MaxSince(rbt.Root)
+}
+
+// Min returns the pointer to the min (leftmost) RBTreeNode in the tree.
+func (rbt *RedBlackTree[T]) Min() *RBTreeNode[T] {
+	return rbt.MinSince(rbt.Root)
+}
+
+// Successor find the minimum tree node that is bigger than (to the right of) the current node.
+//
+// It will return nil if the current node is nil.
+func (rbt *RedBlackTree[T]) Successor(node *RBTreeNode[T]) *RBTreeNode[T] {
+	if node == nil || node == rbt.sentinel {
+		return nil
+	}
+	if node.Right != rbt.sentinel {
+		return rbt.MinSince(node.Right)
+	}
+	y := node.Parent
+	x := node
+	for y != rbt.sentinel && x == y.Right {
+		x = y
+		y = y.Parent
+	}
+	if y == rbt.sentinel {
+		return nil
+	}
+	return y
+}
+
+// Predecessor find the maximum tree node that is smaller than (to the left of) the current node.
+//
+// It will return nil if the current node is nil.
+func (rbt *RedBlackTree[T]) Predecessor(node *RBTreeNode[T]) *RBTreeNode[T] {
+	if node == nil || node == rbt.sentinel {
+		return nil
+	}
+	if node.Left != rbt.sentinel {
+		return rbt.MaxSince(node.Left)
+	}
+	y := node.Parent
+	x := node
+	for y != rbt.sentinel && x == y.Left {
+		x = y
+		y = y.Parent
+	}
+	if y == rbt.sentinel {
+		return nil
+	}
+	return y
+}
+
+// returns the smallest node that is bigger than val (strict) or bigger than or equal to val (not strict).
+func (rbt *RedBlackTree[T]) ceiling(val T, strict bool) (*RBTreeNode[T], bool) {
+	var r *RBTreeNode[T]
+	cur := rbt.Root
+	for cur != nil && cur != rbt.sentinel {
+		c := rbt.compare(cur.Val, val)
+		if c == 1 || (c == 0 && !strict) {  // cur is a candidate; look for a smaller one
+			r = cur
+			cur = cur.Left
+		} else {
+			cur = cur.Right
+		}
+	}
+	return r, r != nil
+}
+
+// returns the biggest node that is smaller than val (strict) or smaller than or equal to val (not strict).
+func (rbt *RedBlackTree[T]) floor(val T, strict bool) (*RBTreeNode[T], bool) {
+	var r *RBTreeNode[T]
+	cur := rbt.Root
+	for cur != nil && cur != rbt.sentinel {
+		c := rbt.compare(cur.Val, val)
+		if c == -1 || (c == 0 && !strict) {  // cur is a candidate; look for a bigger one
+			r = cur
+			cur = cur.Right
+		} else {
+			cur = cur.Left
+		}
+	}
+	return r, r != nil
+}
+
+// Ceiling returns the smallest node whose value is bigger than or equal to val.
+func (rbt *RedBlackTree[T]) Ceiling(val T) (*RBTreeNode[T], bool) {
+	return rbt.ceiling(val, false)
+}
+
+// Higher returns the smallest node whose value is strictly bigger than val.
+func (rbt *RedBlackTree[T]) Higher(val T) (*RBTreeNode[T], bool) {
+	return rbt.ceiling(val, true)
+}
+
+// Floor returns the biggest node whose value is smaller than or equal to val.
+func (rbt *RedBlackTree[T]) Floor(val T) (*RBTreeNode[T], bool) {
+	return rbt.floor(val, false)
+}
+
+// Lower returns the biggest node whose value is strictly smaller than val.
+func (rbt *RedBlackTree[T]) Lower(val T) (*RBTreeNode[T], bool) {
+	return rbt.floor(val, true)
+}
+
+// Range returns all the values between lo and hi in an ascending order.
+//
+// inclusiveLo and inclusiveHi decide whether the values equal to lo and hi are included.
+//
+// It starts from the Ceiling (or Higher) node and follows the successors, so it costs O(log n + k).
+func (rbt *RedBlackTree[T]) Range(lo, hi T, inclusiveLo, inclusiveHi bool) []T {
+	r := make([]T, 0)
+	node, _ := rbt.ceiling(lo, !inclusiveLo)
+	for node != nil {
+		c := rbt.compare(node.Val, hi)
+		if c == 1 || (c == 0 && !inclusiveHi) {
+			break
+		}
+		r = append(r, node.Val)
+		node = rbt.Successor(node)
+	}
+	return r
+}
+
+// left-rotates the subtree for balance
+func (rbt *RedBlackTree[T]) leftRotate(node *RBTreeNode[T]) {
+	if node.Right == rbt.sentinel {
+		return
+	}
+	y := node.Right
+	node.Right = y.Left
+	if y.Left != rbt.sentinel {
+		y.Left.Parent = node
+	}
+	y.Parent = node.Parent
+	if node.Parent == rbt.sentinel {
+		rbt.Root = y
+	} else if node == node.Parent.Left {
+		node.Parent.Left = y
+	} else {
+		node.Parent.Right = y
+	}
+	y.Left = node
+	node.Parent = y
+
+	y.Size = node.Size
+	node.Size = node.Left.Size + node.Right.Size + 1
+}
+
+// right-rotates the subtree for balance
+func (rbt *RedBlackTree[T]) rightRotate(node *RBTreeNode[T]) {
+	if node.Left == rbt.sentinel {
+		return
+	}
+	x := node.Left
+	node.Left = x.Right
+	if x.Right != rbt.sentinel {
+		x.Right.Parent = node
+	}
+	x.Parent = node.Parent
+	if node.Parent == rbt.sentinel {
+		rbt.Root = x
+	} else if node == node.Parent.Left {
+		node.Parent.Left = x
+	} else {
+		node.Parent.Right = x
+	}
+	x.Right = node
+	node.Parent = x
+
+	x.Size = node.Size
+	node.Size = node.Left.Size + node.Right.Size + 1
+}
+
+func (rbt *RedBlackTree[T]) insert(val T, safe bool) bool {
+	node := NewRBTreeNode(val, red)
+	prev := rbt.sentinel
+	cur := rbt.Root
+	for cur != nil && cur != rbt.sentinel {
+		prev = cur
+		c := rbt.compare(cur.Val, val)
+		if c == 1 {  // cur.Val > val
+			cur = cur.Left
+		} else {
+			if c == 0 && safe {
+				return false
+			}
+			cur = cur.Right
+		}
+	}
+
+	node.Parent = prev
+	if prev == rbt.sentinel {
+		rbt.Root = node
+	} else if rbt.compare(prev.Val, val) == 1 {
+		prev.Left = node
+	} else {
+		prev.Right = node
+	}
+
+	node.Left = rbt.sentinel
+	node.Right = rbt.sentinel
+	rbt.updateSizeSince(prev, 1)
+	rbt.insertFixup(node)
+	return true
+}
+
+// adds delta to the sizes of the node and all its ancestors.
+func (rbt *RedBlackTree[T]) updateSizeSince(node *RBTreeNode[T], delta int) {
+	for node != nil && node != rbt.sentinel {
+		node.Size += delta
+		node = node.Parent
+	}
+}
+
+// restores the red-black tree property
+func (rbt *RedBlackTree[T]) insertFixup(node *RBTreeNode[T]) {
+	for node.Parent.Color == red {
+		if node.Parent == node.Parent.Parent.Left {
+			y := node.Parent.Parent.Right
+
+			if y.Color == red {  // case 1
+				node.Parent.Color = black
+				y.Color = black
+				node.Parent.Parent.Color = red
+				node = node.Parent.Parent
+			} else {
+				if node == node.Parent.Right {  // case 2
+					node = node.Parent
+					rbt.leftRotate(node)
+				}
+				node.Parent.Color = black  // case 3
+				node.Parent.Parent.Color = red
+				rbt.rightRotate(node.Parent.Parent)
+			}
+		} else if node.Parent == node.Parent.Parent.Right {
+			y := node.Parent.Parent.Left
+
+			if y.Color == red {
+				node.Parent.Color = black
+				y.Color = black
+				node.Parent.Parent.Color = red
+				node = node.Parent.Parent
+			} else {
+				if node == node.Parent.Left {
+					node = node.Parent
+					rbt.rightRotate(node)
+				}
+				node.Parent.Color = black
+				node.Parent.Parent.Color = red
+				rbt.leftRotate(node.Parent.Parent)
+			}
+		}
+	}
+	rbt.Root.Color = black
+}
+
+// Insert inserts a new val as a new node.
+//
+// Does not insert if the val already exists in the tree.
+func (rbt *RedBlackTree[T]) Insert(val T) bool {
+	return rbt.insert(val, true)
+}
+
+// UnsafeInsert inserts a new val as a new node and allows the same val to be inserted for multiple times.
+func (rbt *RedBlackTree[T]) UnsafeInsert(val T) {
+	rbt.insert(val, false)
+}
+
+// uses subtree n2 to replace subtree n1 by connecting n2 and the parent of n1.
+//
+// It does not update the child of n1 or n2.
+func (rbt *RedBlackTree[T]) transplant(n1, n2 *RBTreeNode[T]) {
+	if n1.Parent == rbt.sentinel {
+		rbt.Root = n2
+	} else if n1 == n1.Parent.Left {
+		n1.Parent.Left = n2
+	} else {
+		n1.Parent.Right = n2
+	}
+	n2.Parent = n1.Parent
+}
+
+// restores the red-black tree property.
+func (rbt *RedBlackTree[T]) deleteFixup(node *RBTreeNode[T]) {
+	for node != rbt.Root && node != rbt.sentinel && node.Color == black {
+		if node == node.Parent.Left {
+			w := node.Parent.Right
+			if w.Color == red {  // case 1
+				w.Color = black
+				node.Parent.Color = red
+				rbt.leftRotate(node.Parent)
+				w = node.Parent.Right
+			}
+			if w.Left.Color == black && w.Right.Color == black {  // case 2
+				w.Color = red
+				node = node.Parent
+			} else {
+				if w.Right.Color == black {  // case 3
+					w.Left.Color = black
+					w.Color = red
+					rbt.rightRotate(w)
+					w = node.Parent.Right
+				}
+				// case 4
+				w.Color = node.Parent.Color
+				node.Parent.Color = black
+				w.Right.Color = black
+				rbt.leftRotate(node.Parent)
+				node = rbt.Root
+			}
+		} else if node == node.Parent.Right {
+			w := node.Parent.Left
+			if w.Color == red {
+				w.Color = black
+				node.Parent.Color = red
+				rbt.rightRotate(node.Parent)
+				w = node.Parent.Left
+			}
+			if w.Right.Color == black && w.Left.Color == black {
+				w.Color = red
+				node = node.Parent
+			} else {
+				if w.Left.Color == black {
+					w.Right.Color = black
+					w.Color = red
+					rbt.leftRotate(w)
+					w = node.Parent.Left
+				}
+				w.Color = node.Parent.Color
+				node.Parent.Color = black
+				w.Left.Color = black
+				rbt.rightRotate(node.Parent)
+				node = rbt.Root
+			}
+		}
+	}
+	node.Color = black
+}
+
+// DeleteNode deletes the node from the tree.
+//
+// it returns a boolean value indicating if the deletion is successful.
+func (rbt *RedBlackTree[T]) DeleteNode(node *RBTreeNode[T]) bool {
+	if node == nil {
+		return false
+	}
+
+	y := node
+	yOriginalColor := y.Color
+	var x *RBTreeNode[T]
+
+	if node.Left == rbt.sentinel || node.Right == rbt.sentinel {
+		rbt.updateSizeSince(node.Parent, -1)
+	} else {
+		rbt.updateSizeSince(rbt.MinSince(node.Right).Parent, -1)  // the successor is the node to be moved
+	}
+
+	if node.Left == rbt.sentinel {
+		x = node.Right
+		rbt.transplant(node, node.Right)
+	} else if node.Right == rbt.sentinel {
+		x = node.Left
+		rbt.transplant(node, node.Left)
+	} else {
+		y = rbt.MinSince(node.Right)  // the successor of node
+		yOriginalColor = y.Color
+		x = y.Right
+		if y.Parent != node {
+			rbt.transplant(y, y.Right)
+			y.Right = node.Right
+			y.Right.Parent = y
+		} else {
+			x.Parent = y
+		}
+		rbt.transplant(node, y)
+		y.Left = node.Left
+		y.Left.Parent = y
+		y.Color = node.Color
+		y.Size = node.Size
+	}
+
+	if yOriginalColor == black {
+		rbt.deleteFixup(x)
+	}
+	return true
+}
+
+// Delete deletes the First node with the corresponding value if it exists.
+//
+// it returns a boolean value indicating if the deletion is successful.
+func (rbt *RedBlackTree[T]) Delete(val T) bool {
+	node, _ := rbt.Search(val)
+	return rbt.DeleteNode(node)
+}
+
+// Size returns the number of nodes in the tree.
+func (rbt *RedBlackTree[T]) Size() int {
+	if rbt.Root == nil {
+		return 0
+	}
+	return rbt.Root.Size
+}
+
+// Select returns the node with the k th smallest value; k starts from 0.
+//
+// It returns nil and false if k is out of range. It costs O(log n).
+func (rbt *RedBlackTree[T]) Select(k int) (*RBTreeNode[T], bool) {
+	if k < 0 || k >= rbt.Size() {
+		return nil, false
+	}
+	cur := rbt.Root
+	for {
+		r := cur.Left.Size  // the number of values smaller than cur in the subtree
+		if k == r {
+			return cur, true
+		} else if k < r {
+			cur = cur.Left
+		} else {
+			k -= r + 1
+			cur = cur.Right
+		}
+	}
+}
+
+// Rank returns the number of values in the tree that are smaller than val.
+//
+// Therefore, if val is in the tree, Select(Rank(val)) returns its FIRST corresponding node. It costs O(log n).
+func (rbt *RedBlackTree[T]) Rank(val T) int {
+	r := 0
+	cur := rbt.Root
+	for cur != nil && cur != rbt.sentinel {
+		if rbt.compare(cur.Val, val) == -1 {  // cur.Val < val
+			r += cur.Left.Size + 1
+			cur = cur.Right
+		} else {
+			cur = cur.Left
+		}
+	}
+	return r
+}
+
+// Height returns the height of the tree.
+//
+// Warning: it uses dfs and is expensive.
+func (rbt *RedBlackTree[T]) Height() int {
+	max := 0
+	count := 0
+	var dfs func(cur *RBTreeNode[T])
+	dfs = func(cur *RBTreeNode[T]) {
+		if cur != rbt.sentinel {
+			count ++
+			if count > max {
+				max = count
+			}
+			dfs(cur.Left)
+			dfs(cur.Right)
+			count --
+		}
+	}
+	dfs(rbt.Root)
+	return max
+}
+
+// NewRedBlackTree returns a new RedBlackTree object.
+func NewRedBlackTree[T any](compare func(a, b T) int) *RedBlackTree[T] {
+	var zero T
+	sentinel := NewRBTreeNode(zero, black)
+	sentinel.Size = 0
+	return &RedBlackTree[T]{compare: compare, sentinel: sentinel}
+}
+
+// Iterator returns a cursor walking through the values in an ascending order.
+//
+// The values are visited lazily by following the successors; modifying the tree invalidates the cursor.
+func (rbt *RedBlackTree[T]) Iterator() *RBTreeIterator[T] {
+	return &RBTreeIterator[T]{tree: rbt, node: rbt.validNode(rbt.Min())}
+}
+
+// ReverseIterator returns a cursor walking through the values in a descending order.
+//
+// The values are visited lazily by following the predecessors; modifying the tree invalidates the cursor.
+func (rbt *RedBlackTree[T]) ReverseIterator() *RBTreeIterator[T] {
+	return &RBTreeIterator[T]{tree: rbt, node: rbt.validNode(rbt.Max()), reverse: true}
+}
+
+// returns nil for the sentinel.
+func (rbt *RedBlackTree[T]) validNode(node *RBTreeNode[T]) *RBTreeNode[T] {
+	if node == rbt.sentinel {
+		return nil
+	}
+	return node
+}
+
+// RBTreeIterator
+//
+// The cursor over a RedBlackTree. Please use RedBlackTree.Iterator() or RedBlackTree.ReverseIterator() to create one.
+type RBTreeIterator[T any] struct {
+	tree *RedBlackTree[T]
+	node *RBTreeNode[T]  // the next node to visit; will be nil if there are no more values
+	reverse bool
+}
+
+// Next returns the next value and true, or the zero value of T and false if there are no more values.
+func (it *RBTreeIterator[T]) Next() (T, bool) {
+	if it.node == nil {
+		var zero T
+		return zero, false
+	}
+	v := it.node.Val
+	if it.reverse {
+		it.node = it.tree.Predecessor(it.node)
+	} else {
+		it.node = it.tree.Successor(it.node)
+	}
+	return v, true
+}
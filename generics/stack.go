@@ -0,0 +1,60 @@
+package generics
+
+// Stack
+//
+// The stack structure. Please use NewStack() as the safe constructor; the zero value is also an
+// empty stack.
+//
+// It is a thin wrapper over Deque, which pushes to & pops from the back.
+type Stack[T any] struct {
+	deque Deque[T]
+}
+
+func (sk *Stack[T]) Len() int {
+	return sk.deque.Len()
+}
+
+func (sk *Stack[T]) HasNext() bool {
+	return sk.deque.HasNext()
+}
+
+// IsEmpty returns true if the stack has no values.
+func (sk *Stack[T]) IsEmpty() bool {
+	return sk.deque.IsEmpty()
+}
+
+// Pop pops out the element on the top of the stack.
+//
+// Please check if the stack is empty before using this method; it returns the zero value of T if the stack is empty.
+//
+// e.g.,
+//
+// if stack.HasNext() { stack.Pop() }
+func (sk *Stack[T]) Pop() T {
+	v, _ := sk.deque.PopBack()
+	return v
+}
+
+// Push pushes v into the stack.
+func (sk *Stack[T]) Push(v T) {
+	sk.deque.PushBack(v)
+}
+
+// Values returns the values in the stack, from the bottom to the top.
+func (sk *Stack[T]) Values() []T {
+	return sk.deque.Values()
+}
+
+// Empty completely empties the stack.
+func (sk *Stack[T]) Empty() {
+	sk.deque.Clear()
+}
+
+// Copy makes a deep copy.
+func (sk *Stack[T]) Copy() *Stack[T] {
+	return &Stack[T]{deque: *sk.deque.Copy()}
+}
+
+func NewStack[T any]() *Stack[T] {
+	return &Stack[T]{deque: *NewDeque[T]()}
+}
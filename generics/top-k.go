@@ -0,0 +1,101 @@
+package generics
+
+import (
+	"fmt"
+	"sort"
+)
+
+// TopK
+//
+// The bounded collector keeping only the k largest (depending on the compare method) values offered so far,
+// e.g., the top 100 items by score over a stream. Please use NewTopK() as the safe constructor.
+//
+// It is a BinaryHeap with the reversed compare method, so the worst kept value is at the top and Offer costs O(log k).
+//
+// .
+//
+// compare is the function for comparing different values;
+// it should return 1 if a > b , 0 if a == b, -1 if a < b
+type TopK[T any] struct {
+	k int
+	heap *BinaryHeap[T]
+	compare func(a, b T) int
+}
+
+// K returns the capacity.
+func (tk *TopK[T]) K() int {
+	return tk.k
+}
+
+// Len returns the number of values kept.
+func (tk *TopK[T]) Len() int {
+	return tk.heap.Size()
+}
+
+// IsEmpty returns true if no values are kept.
+func (tk *TopK[T]) IsEmpty() bool {
+	return tk.heap.IsEmpty()
+}
+
+// Values returns the values kept; the order is not specified. Please use Items for the sorted values.
+func (tk *TopK[T]) Values() []T {
+	return tk.heap.Values()
+}
+
+// Offer offers v to the collector, and returns true if v is kept.
+//
+// When the collector is full, v replaces the worst kept value if v is larger; a value equal to the worst one
+// is not kept, so the values offered earlier win the ties.
+func (tk *TopK[T]) Offer(v T) bool {
+	if tk.heap.Size() < tk.k {
+		_ = tk.heap.Insert(v)
+		return true
+	}
+	if tk.compare(v, tk.heap.Heap[1]) != 1 {
+		return false
+	}
+	tk.heap.Heap[1] = v  // replaces the worst value
+	tk.heap.maxHeapify(1)
+	return true
+}
+
+// Items returns the values kept, sorted from the largest to the smallest.
+//
+// The values themselves are returned instead of copies.
+func (tk *TopK[T]) Items() []T {
+	r := make([]T, tk.heap.Size())
+	copy(r, tk.heap.Heap[1:tk.heap.Size() + 1])
+	sort.Slice(r, func(i, j int) bool {
+		return tk.compare(r[i], r[j]) == 1
+	})
+	return r
+}
+
+// Merge offers all the values kept by other, e.g., to combine the partial results from different workers.
+//
+// other is not modified. Merging the collector with itself does nothing.
+func (tk *TopK[T]) Merge(other *TopK[T]) {
+	if other == tk {
+		return
+	}
+	for i := 1; i <= other.heap.Size(); i ++ {
+		tk.Offer(other.heap.Heap[i])
+	}
+}
+
+// NewTopK returns a new TopK object keeping at most k values.
+//
+// k must > 0; otherwise it will return nil.
+//
+// compare is the function for comparing different values;
+// it should return 1 if a > b , 0 if a == b, -1 if a < b.
+func NewTopK[T any](k int, compare func(a, b T) int) *TopK[T] {
+	if k < 1 {
+		fmt.Println("the capacity k must be > 0")
+		return nil
+	}
+	reversed := func(a, b T) int {
+		return compare(b, a)
+	}
+	return &TopK[T]{k: k, heap: NewBinaryHeap(reversed), compare: compare}
+}
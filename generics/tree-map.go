@@ -0,0 +1,213 @@
+package generics
+
+// Entry
+//
+// The key-value pair stored in TreeMap and BTreeMap.
+type Entry[K any, V any] struct {
+	Key K
+	Value V
+}
+
+// wraps the key comparator into a comparator of entries, which ignores the values.
+func compareEntry[K any, V any](compare func(a, b K) int) func(a, b Entry[K, V]) int {
+	return func(a, b Entry[K, V]) int {
+		return compare(a.Key, b.Key)
+	}
+}
+
+// TreeMap
+//
+// The ordered map built on RedBlackTree. Please use NewTreeMap() as the safe constructor.
+//
+// Each key appears at most once; the entries are ordered by their keys.
+//
+// .
+//
+// compare is the function for comparing different keys;
+// it should return 1 if a > b , 0 if a == b, -1 if a < b.
+//
+// .
+//
+// Different from RedBlackTree, the comparator only sees the keys, so there is no need to pack the keys and the values
+// into a struct with a tricky compare method.
+type TreeMap[K any, V any] struct {
+	tree *RedBlackTree[Entry[K, V]]
+}
+
+// Put associates the value with the key, and returns true if the key is new to the map.
+//
+// If the key already exists, its value is replaced.
+func (tm *TreeMap[K, V]) Put(key K, value V) bool {
+	if node, b := tm.tree.Search(Entry[K, V]{Key: key}); b {
+		node.Val.Value = value
+		return false
+	}
+	tm.tree.UnsafeInsert(Entry[K, V]{Key: key, Value: value})
+	return true
+}
+
+// Get returns the value associated with the key, and a boolean indicating whether the key exists.
+func (tm *TreeMap[K, V]) Get(key K) (V, bool) {
+	if node, b := tm.tree.Search(Entry[K, V]{Key: key}); b {
+		return node.Val.Value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// ContainsKey returns true if the key exists in the map.
+func (tm *TreeMap[K, V]) ContainsKey(key K) bool {
+	_, b := tm.tree.Search(Entry[K, V]{Key: key})
+	return b
+}
+
+// Remove removes the key and its value, and returns a boolean indicating whether the key exists.
+func (tm *TreeMap[K, V]) Remove(key K) bool {
+	return tm.tree.Delete(Entry[K, V]{Key: key})
+}
+
+// Keys returns all the keys in an ascending order.
+func (tm *TreeMap[K, V]) Keys() []K {
+	entries := tm.tree.InOrderTreeWalk()
+	r := make([]K, len(entries))
+	for i, entry := range entries {
+		r[i] = entry.Key
+	}
+	return r
+}
+
+// Entries returns all the key-value pairs, ordered by the keys.
+func (tm *TreeMap[K, V]) Entries() []Entry[K, V] {
+	return tm.tree.InOrderTreeWalk()
+}
+
+// Values returns all the values, ordered by their keys.
+func (tm *TreeMap[K, V]) Values() []V {
+	entries := tm.tree.InOrderTreeWalk()
+	r := make([]V, len(entries))
+	for i, entry := range entries {
+		r[i] = entry.Value
+	}
+	return r
+}
+
+// Size returns the number of keys in the map.
+func (tm *TreeMap[K, V]) Size() int {
+	return tm.tree.Size()
+}
+
+// Len returns the number of keys in the map; same as Size.
+func (tm *TreeMap[K, V]) Len() int {
+	return tm.tree.Size()
+}
+
+// IsEmpty returns true if the map has no keys.
+func (tm *TreeMap[K, V]) IsEmpty() bool {
+	return tm.tree.Size() == 0
+}
+
+// NewTreeMap returns an empty TreeMap object.
+func NewTreeMap[K any, V any](compare func(a, b K) int) *TreeMap[K, V] {
+	return &TreeMap[K, V]{tree: NewRedBlackTree(compareEntry[K, V](compare))}
+}
+
+// BTreeMap
+//
+// The ordered map built on BTree. Please use NewBTreeMap() as the safe constructor.
+//
+// Each key appears at most once; the entries are ordered by their keys.
+//
+// t int
+//
+//		The minimum degree of the underlying BTree; see NewBTree for details.
+//
+// .
+//
+// compare is the function for comparing different keys;
+// it should return 1 if a > b , 0 if a == b, -1 if a < b.
+type BTreeMap[K any, V any] struct {
+	tree *BTree[Entry[K, V]]
+}
+
+// Put associates the value with the key, and returns true if the key is new to the map.
+//
+// If the key already exists, its value is replaced.
+func (bm *BTreeMap[K, V]) Put(key K, value V) bool {
+	if node, i, b := bm.tree.Search(Entry[K, V]{Key: key}); b {
+		node.Keys[i].Value = value
+		return false
+	}
+	bm.tree.Insert(Entry[K, V]{Key: key, Value: value})
+	return true
+}
+
+// Get returns the value associated with the key, and a boolean indicating whether the key exists.
+func (bm *BTreeMap[K, V]) Get(key K) (V, bool) {
+	if node, i, b := bm.tree.Search(Entry[K, V]{Key: key}); b {
+		return node.Keys[i].Value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// ContainsKey returns true if the key exists in the map.
+func (bm *BTreeMap[K, V]) ContainsKey(key K) bool {
+	_, _, b := bm.tree.Search(Entry[K, V]{Key: key})
+	return b
+}
+
+// Remove removes the key and its value, and returns a boolean indicating whether the key exists.
+func (bm *BTreeMap[K, V]) Remove(key K) bool {
+	return bm.tree.Delete(Entry[K, V]{Key: key})
+}
+
+// Keys returns all the keys in an ascending order.
+func (bm *BTreeMap[K, V]) Keys() []K {
+	entries := bm.tree.Values()
+	r := make([]K, len(entries))
+	for i, entry := range entries {
+		r[i] = entry.Key
+	}
+	return r
+}
+
+// Entries returns all the key-value pairs, ordered by the keys.
+func (bm *BTreeMap[K, V]) Entries() []Entry[K, V] {
+	return bm.tree.Values()
+}
+
+// Values returns all the values, ordered by their keys.
+func (bm *BTreeMap[K, V]) Values() []V {
+	entries := bm.tree.Values()
+	r := make([]V, len(entries))
+	for i, entry := range entries {
+		r[i] = entry.Value
+	}
+	return r
+}
+
+// Size returns the number of keys in the map.
+func (bm *BTreeMap[K, V]) Size() int {
+	return bm.tree.NumOfElements()
+}
+
+// Len returns the number of keys in the map; same as Size.
+func (bm *BTreeMap[K, V]) Len() int {
+	return bm.tree.NumOfElements()
+}
+
+// IsEmpty returns true if the map has no keys.
+func (bm *BTreeMap[K, V]) IsEmpty() bool {
+	return bm.tree.NumOfElements() == 0
+}
+
+// NewBTreeMap returns an empty BTreeMap object.
+//
+// t must > 1; otherwise it will return nil.
+func NewBTreeMap[K any, V any](t int, compare func(a, b K) int) *BTreeMap[K, V] {
+	tree := NewBTree(t, compareEntry[K, V](compare))
+	if tree == nil {
+		return nil
+	}
+	return &BTreeMap[K, V]{tree: tree}
+}
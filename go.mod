@@ -1,3 +1,3 @@
 module some-data-structures
 
-go 1.16
+go 1.18
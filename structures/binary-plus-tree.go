@@ -1,3 +1,490 @@
 package structures
 
+import (
+	"fmt"
+)
 
+// BPlusTree
+//
+// The B+ tree structure. Please use NewBPlusTree() as the safe constructor.
+//
+// Attributes:
+//
+//		t int
+//
+//		num int
+//
+//		Root *BPlusTreeNode
+//
+//		compare func(a, b interface{}) int
+//
+// .
+//
+// t int
+//
+//		The minimum degree.
+//		Every node except root must contain at least t-1 keys. The root may contain minimum 1 key.
+//		All nodes (including root) may contain at most 2*t – 1 keys.
+//
+// .
+//
+// Different from BTree, the values are only stored in the leaf nodes, and the leaf nodes are linked together
+// in an ascending order; the keys of the internal nodes are only used as separators. Therefore, range scans can
+// walk along the leaves without going back up the tree.
+//
+// .
+//
+// compare is the function for comparing different node values;
+// it should return 1 if a > b , 0 if a == b, -1 if a < b
+//
+// .
+//
+// The first input of the compare function should be the same type as the value of the tree node; the second input may have
+// variant types. A tricky compare method can relax the conditions for Search and Delete; see examples for details.
+//
+// .
+//
+// Note that this BPlusTree does not perform type checking; please include any necessary type checking
+// in the customized compare function
+type BPlusTree struct {
+	t int
+	num int  // track number of elements in the tree
+	Root *BPlusTreeNode
+	compare func(a, b interface{}) int
+}
+
+// T returns the minimum degree
+func (bpt *BPlusTree) T() int {
+	return bpt.t
+}
+
+// NumOfElements returns the number of elements in the BPlusTree
+func (bpt *BPlusTree) NumOfElements() int {
+	return bpt.num
+}
+
+// Len returns the number of elements in the BPlusTree; same as NumOfElements
+func (bpt *BPlusTree) Len() int {
+	return bpt.num
+}
+
+// IsEmpty returns true if the BPlusTree has no elements
+func (bpt *BPlusTree) IsEmpty() bool {
+	return bpt.num == 0
+}
+
+// Contains returns true if the val exists in the BPlusTree
+func (bpt *BPlusTree) Contains(val interface{}) bool {
+	_, _, b := bpt.Search(val)
+	return b
+}
+
+// finds the number of keys in node.Keys that are smaller than val.
+func (bpt *BPlusTree) findKey(node *BPlusTreeNode, val interface{}) int {
+	r := 0
+	for r < node.N && bpt.compare(node.Keys[r], val) == -1 {
+		r ++
+	}
+	return r
+}
+
+// returns the leftmost leaf node.
+func (bpt *BPlusTree) firstLeaf() *BPlusTreeNode {
+	cur := bpt.Root
+	for !cur.IsLeaf {
+		cur = cur.Children[0]
+	}
+	return cur
+}
+
+// returns the rightmost leaf node.
+func (bpt *BPlusTree) lastLeaf() *BPlusTreeNode {
+	cur := bpt.Root
+	for !cur.IsLeaf {
+		cur = cur.Children[cur.N]
+	}
+	return cur
+}
+
+// returns the leaf node and the index of the first value that is bigger than or equal to val.
+//
+// The node will be nil if there is no such value.
+func (bpt *BPlusTree) locate(val interface{}) (*BPlusTreeNode, int) {
+	cur := bpt.Root
+	for !cur.IsLeaf {
+		cur = cur.Children[bpt.findKey(cur, val)]
+	}
+	i := bpt.findKey(cur, val)
+	for cur != nil && i >= cur.N {  // all the values in this leaf are smaller; go to the next leaf
+		cur = cur.Next
+		i = 0
+	}
+	return cur, i
+}
+
+// Search searches for an element in the BPlusTree.
+//
+// Returns the first corresponding leaf node, the index of the corresponding value on the node,
+// and a boolean indicating whether the searching is successful.
+func (bpt *BPlusTree) Search(val interface{}) (*BPlusTreeNode, int, bool) {
+	node, i := bpt.locate(val)
+	if node != nil && bpt.compare(node.Keys[i], val) == 0 {
+		return node, i, true
+	}
+	return nil, -1, false
+}
+
+// splits the index th child of the node; index starts from 0.
+//
+// For a leaf child, the first key of the new right leaf is copied into the node as the separator;
+// for an internal child, the mid-key is moved up as in BTree.
+func (bpt *BPlusTree) splitChild(node *BPlusTreeNode, index int) {
+	t := bpt.t
+	y := node.Children[index]
+	z := NewBPlusTreeNode(t, y.IsLeaf)
+	var separator interface{}
+
+	if y.IsLeaf {
+		z.N = t  // y keeps t - 1 keys and z takes the other t keys
+		for i := 0; i < t; i ++ {
+			z.Keys[i] = y.Keys[i + t - 1]
+		}
+		separator = z.Keys[0]
+
+		z.Next = y.Next
+		if y.Next != nil {
+			y.Next.Prev = z
+		}
+		y.Next = z
+		z.Prev = y
+	} else {
+		z.N = t - 1
+		for i := 0; i < t - 1; i ++ {
+			z.Keys[i] = y.Keys[i + t]
+		}
+		for i := 0; i < t; i ++ {
+			z.Children[i] = y.Children[i + t]
+		}
+		separator = y.Keys[t - 1]
+	}
+
+	y.N = t - 1
+
+	for i := node.N; i >= index + 1; i -- {  // note that we assume node itself is not full
+		node.Children[i + 1] = node.Children[i]
+	}
+	node.Children[index + 1] = z
+
+	for i := node.N - 1; i >= index; i -- {
+		node.Keys[i + 1] = node.Keys[i]
+	}
+	node.Keys[index] = separator
+	node.N ++
+}
+
+// inserts val into the node; the node must not be full
+func (bpt *BPlusTree) insertNotFull(node *BPlusTreeNode, val interface{}) {
+	i := node.N - 1
+	if node.IsLeaf {
+		for i >= 0 && bpt.compare(node.Keys[i], val) == 1 {
+			node.Keys[i + 1] = node.Keys[i]
+			i --
+		}
+		node.Keys[i + 1] = val
+		node.N ++
+	} else {
+		for i >= 0 && bpt.compare(node.Keys[i], val) == 1 {
+			i --
+		}
+		i ++  // to get the child after i th key
+		if node.Children[i].N == 2 * bpt.t - 1 {
+			bpt.splitChild(node, i)
+			if bpt.compare(node.Keys[i], val) == -1 {  // the new separator may be smaller than val
+				i ++
+			}
+		}
+		bpt.insertNotFull(node.Children[i], val)
+	}
+}
+
+// Insert inserts a new value into the B+ tree.
+func (bpt *BPlusTree) Insert(val interface{}) {
+	cur := bpt.Root
+	if cur.N == 2 * bpt.t - 1 {
+		s := NewBPlusTreeNode(bpt.t, false)
+		bpt.Root = s
+		s.Children[0] = cur
+		bpt.splitChild(s, 0)  // this will also set keys for s
+		bpt.insertNotFull(s, val)
+	} else {
+		bpt.insertNotFull(cur, val)
+	}
+
+	bpt.num ++
+}
+
+// removes val from the sub-tree rooted with this node.
+//
+// It rebalances the children on the way back, so the node itself may be left with less than t - 1 keys.
+func (bpt *BPlusTree) removeFromNode(node *BPlusTreeNode, val interface{}) bool {
+	idx := bpt.findKey(node, val)
+	if node.IsLeaf {
+		if idx < node.N && bpt.compare(node.Keys[idx], val) == 0 {
+			for i := idx + 1; i < node.N; i ++ {
+				node.Keys[i - 1] = node.Keys[i]
+			}
+			node.N --
+			node.Keys[node.N] = nil
+			return true
+		}
+		return false
+	}
+
+	// equal values may be spread over several children, so keep trying while the separator equals val
+	for ; idx <= node.N; idx ++ {
+		if bpt.removeFromNode(node.Children[idx], val) {
+			if node.Children[idx].N < bpt.t - 1 {
+				bpt.fill(node, idx)
+			}
+			return true
+		}
+		if idx == node.N || bpt.compare(node.Keys[idx], val) != 0 {
+			break
+		}
+	}
+	return false
+}
+
+// combines 2 neighboring children of the node.
+func (bpt *BPlusTree) combineChildren(node *BPlusTreeNode, index1 int, index2 int) {
+	y1 := node.Children[index1]
+	y2 := node.Children[index2]
+
+	if y1.IsLeaf {
+		for i := 0; i < y2.N; i ++ {  // copying keys
+			y1.Keys[y1.N + i] = y2.Keys[i]
+		}
+		y1.N += y2.N
+
+		y1.Next = y2.Next  // unlink y2 from the leaf list
+		if y2.Next != nil {
+			y2.Next.Prev = y1
+		}
+	} else {
+		y1.Keys[y1.N] = node.Keys[index1]  // pull down the separator
+
+		for i := 0; i < y2.N; i ++ {  // copying keys
+			y1.Keys[y1.N + 1 + i] = y2.Keys[i]
+		}
+		for i := 0; i <= y2.N; i ++ {  // copying children
+			y1.Children[y1.N + 1 + i] = y2.Children[i]
+		}
+		y1.N += y2.N + 1
+	}
+
+	for i := index2; i < node.N; i ++ {  // move keys
+		node.Keys[i - 1] = node.Keys[i]  // node.Keys[index1] is deleted here
+	}
+
+	for i := index2 + 1; i < node.N + 1; i ++ {  // move children
+		node.Children[i - 1] = node.Children[i]  // node.Children[index2] is deleted here
+	}
+
+	node.Keys[node.N - 1] = nil
+	node.Children[node.N] = nil
+	node.N --
+}
+
+// borrows a key from the previous / left sibling.
+func (bpt *BPlusTree) borrowPrev(node *BPlusTreeNode, index int) {
+	cur := node.Children[index]
+	toBorrow := node.Children[index - 1]
+
+	// the borrowed key will be smaller; so move all keys in cur on step to the right
+	for i := cur.N - 1; i >= 0; i -- {
+		cur.Keys[i + 1] = cur.Keys[i]
+	}
+
+	if cur.IsLeaf {
+		cur.Keys[0] = toBorrow.Keys[toBorrow.N - 1]
+		node.Keys[index - 1] = cur.Keys[0]  // the borrowed value is the new separator
+	} else {
+		for i := cur.N; i >= 0; i -- {
+			cur.Children[i + 1] = cur.Children[i]
+		}
+		cur.Keys[0] = node.Keys[index - 1]
+		cur.Children[0] = toBorrow.Children[toBorrow.N]
+		node.Keys[index - 1] = toBorrow.Keys[toBorrow.N - 1]
+	}
+
+	cur.N ++
+	toBorrow.N --
+}
+
+// borrows a key from the next / right sibling.
+func (bpt *BPlusTree) borrowNext(node *BPlusTreeNode, index int) {
+	cur := node.Children[index]
+	toBorrow := node.Children[index + 1]
+
+	if cur.IsLeaf {
+		cur.Keys[cur.N] = toBorrow.Keys[0]
+		node.Keys[index] = toBorrow.Keys[1]  // the new first value of toBorrow is the new separator
+	} else {
+		cur.Keys[cur.N] = node.Keys[index]
+		cur.Children[cur.N + 1] = toBorrow.Children[0]
+		node.Keys[index] = toBorrow.Keys[0]
+	}
+
+	// updates toBorrow
+	for i := 0; i < toBorrow.N - 1; i ++ {
+		toBorrow.Keys[i] = toBorrow.Keys[i + 1]
+	}
+
+	if !toBorrow.IsLeaf {
+		for i := 0; i < toBorrow.N; i ++ {
+			toBorrow.Children[i] = toBorrow.Children[i + 1]
+		}
+	}
+
+	cur.N ++
+	toBorrow.N --
+}
+
+// fills the child node which has less than t - 1 keys.
+func (bpt *BPlusTree) fill(node *BPlusTreeNode, index int) {
+	// the main idea is to decide where to borrow
+	t := bpt.t
+	if index != 0 && node.Children[index - 1].N >= t {
+		bpt.borrowPrev(node, index)
+	} else if index != node.N && node.Children[index + 1].N >= t {
+		bpt.borrowNext(node, index)
+	} else {  // none of the siblings have extra keys
+		if index != node.N {
+			bpt.combineChildren(node, index, index + 1)
+		} else {
+			bpt.combineChildren(node, index - 1, index)
+		}
+	}
+}
+
+// Delete deletes the first value satisfying the compare condition.
+//
+// it returns a boolean value indicating if the deletion is successful.
+func (bpt *BPlusTree) Delete(val interface{}) bool {
+	b := bpt.removeFromNode(bpt.Root, val)
+	if b {
+		bpt.num --
+	}
+
+	// check if the root has no keys && no children
+	if bpt.Root.N == 0 && !bpt.Root.IsLeaf {
+		bpt.Root = bpt.Root.Children[0]
+	}
+
+	return b
+}
+
+// Values returns all the values in the tree in an ordered manner.
+//
+// It walks along the leaf nodes.
+func (bpt *BPlusTree) Values() []interface{} {
+	r := make([]interface{}, bpt.num)
+	index := 0
+	for cur := bpt.firstLeaf(); cur != nil; cur = cur.Next {
+		for i := 0; i < cur.N; i ++ {
+			r[index] = cur.Keys[i]
+			index ++
+		}
+	}
+	return r
+}
+
+// Range returns an iterator over the values v where lo <= v <= hi, in an ascending order.
+//
+// lo (or hi) can be nil, which means there is no lower (or upper) boundary.
+//
+// The iterator walks along the leaf nodes; modifying the tree invalidates the iterator.
+func (bpt *BPlusTree) Range(lo, hi interface{}) *BPlusTreeIterator {
+	var node *BPlusTreeNode
+	index := 0
+	if lo == nil {
+		node = bpt.firstLeaf()
+		for node != nil && node.N == 0 {
+			node = node.Next
+		}
+	} else {
+		node, index = bpt.locate(lo)
+	}
+	return &BPlusTreeIterator{node: node, index: index, hi: hi, compare: bpt.compare}
+}
+
+// Iterator returns an iterator over all the values in an ascending order; it is the same as Range(nil, nil).
+func (bpt *BPlusTree) Iterator() *BPlusTreeIterator {
+	return bpt.Range(nil, nil)
+}
+
+// ReverseIterator returns an iterator over all the values in a descending order.
+//
+// The iterator walks backwards along the leaf nodes; modifying the tree invalidates the iterator.
+func (bpt *BPlusTree) ReverseIterator() *BPlusTreeIterator {
+	node := bpt.lastLeaf()
+	for node != nil && node.N == 0 {
+		node = node.Prev
+	}
+	index := 0
+	if node != nil {
+		index = node.N - 1
+	}
+	return &BPlusTreeIterator{node: node, index: index, compare: bpt.compare, reverse: true}
+}
+
+// BPlusTreeIterator
+//
+// The iterator walking along the leaf nodes of a BPlusTree.
+// Please use BPlusTree.Range(), BPlusTree.Iterator() or BPlusTree.ReverseIterator() to create one.
+type BPlusTreeIterator struct {
+	node *BPlusTreeNode  // the current leaf node; will be nil if there are no more values
+	index int
+	hi interface{}  // the upper boundary for an ascending iterator
+	compare func(a, b interface{}) int
+	reverse bool
+}
+
+// Next returns the next value and true, or nil and false if there are no more values.
+func (it *BPlusTreeIterator) Next() (interface{}, bool) {
+	if it.node == nil {
+		return nil, false
+	}
+	v := it.node.Keys[it.index]
+	if it.reverse {
+		it.index --
+		for it.node != nil && it.index < 0 {
+			it.node = it.node.Prev
+			if it.node != nil {
+				it.index = it.node.N - 1
+			}
+		}
+		return v, true
+	}
+	if it.hi != nil && it.compare(v, it.hi) == 1 {
+		it.node = nil
+		return nil, false
+	}
+	it.index ++
+	for it.node != nil && it.index >= it.node.N {
+		it.node = it.node.Next
+		it.index = 0
+	}
+	return v, true
+}
+
+// NewBPlusTree returns a new BPlusTree object
+//
+// t must > 1; otherwise it will return nil.
+func NewBPlusTree(t int, compare func(a, b interface{}) int) *BPlusTree {
+	if t < 2 {
+		fmt.Println("the minimum degree t must be > 1")
+		return nil
+	}
+	return &BPlusTree{Root: NewBPlusTreeNode(t, true), t: t, compare: compare}
+}
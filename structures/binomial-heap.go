@@ -0,0 +1,17 @@
+package structures
+
+import (
+	"some-data-structures/generics"
+)
+
+// BinomialHeap
+//
+// The binomial heap structure storing interface{} values, which is an ordered min-heap.
+// Please use NewBinomialHeap() as the safe constructor.
+//
+// It is generics.BinomialHeap instantiated with interface{}; see generics.BinomialHeap for the methods.
+type BinomialHeap = generics.BinomialHeap[interface{}]
+
+func NewBinomialHeap(compare func(a, b interface{}) int) *BinomialHeap {
+	return generics.NewBinomialHeap(compare)
+}
@@ -0,0 +1,363 @@
+package structures
+
+import (
+	"fmt"
+	"time"
+)
+
+// the entry stored in the nodes of the caches.
+type cacheEntry struct {
+	key interface{}
+	value interface{}
+	expireAt time.Time  // zero if the entry never expires
+	freq int  // the number of uses; only for LFUCache
+}
+
+// the common part of LRUCache and LFUCache: the capacity, the TTL, the clock and the eviction callback.
+type cacheBase struct {
+	capacity int
+	ttl time.Duration
+	now func() time.Time
+	onEvict func(key, value interface{})
+}
+
+// Cap returns the capacity.
+func (cb *cacheBase) Cap() int {
+	return cb.capacity
+}
+
+// SetTTL sets the time to live of the entries put afterwards; 0 (the default) means the entries never expire.
+//
+// The expired entries are removed lazily when they are accessed or evicted, or by Purge.
+func (cb *cacheBase) SetTTL(ttl time.Duration) {
+	cb.ttl = ttl
+}
+
+// SetClock replaces the clock used for TTL, which is time.Now by default, e.g., with a fake clock in tests.
+func (cb *cacheBase) SetClock(now func() time.Time) {
+	cb.now = now
+}
+
+// SetOnEvict sets the callback which is called when an entry is evicted because the cache is full, or removed
+// because it has expired. It is not called for Remove or for the values replaced by Put.
+func (cb *cacheBase) SetOnEvict(onEvict func(key, value interface{})) {
+	cb.onEvict = onEvict
+}
+
+// returns the expiry time for an entry put now with the ttl.
+func (cb *cacheBase) expireAt(ttl time.Duration) time.Time {
+	if ttl <= 0 {
+		return time.Time{}
+	}
+	return cb.now().Add(ttl)
+}
+
+func (cb *cacheBase) expired(entry *cacheEntry) bool {
+	return !entry.expireAt.IsZero() && !cb.now().Before(entry.expireAt)
+}
+
+func (cb *cacheBase) evicted(entry *cacheEntry) {
+	if cb.onEvict != nil {
+		cb.onEvict(entry.key, entry.value)
+	}
+}
+
+// LRUCache
+//
+// The cache which evicts the least recently used entry when it is full. Please use NewLRUCache() as the safe
+// constructor.
+//
+// It combines a map with a DoubleLinkedList ordered from the most recently used entry to the least recently used
+// one, so Get, Put, Peek and Remove cost O(1). The keys must be comparable.
+//
+// It is not safe for concurrent use.
+type LRUCache struct {
+	cacheBase
+	items map[interface{}]*BiNode
+	list *DoubleLinkedList
+}
+
+// Len returns the number of entries, including the expired ones which have not been removed yet.
+func (lru *LRUCache) Len() int {
+	return len(lru.items)
+}
+
+// removes the node from the cache.
+func (lru *LRUCache) removeNode(node *BiNode) *cacheEntry {
+	entry := node.Val.(*cacheEntry)
+	lru.list.DeleteNode(node)
+	delete(lru.items, entry.key)
+	return entry
+}
+
+// returns the node of the key, and removes it if it has expired.
+func (lru *LRUCache) lookup(key interface{}) (*BiNode, bool) {
+	node, ok := lru.items[key]
+	if !ok {
+		return nil, false
+	}
+	if entry := node.Val.(*cacheEntry); lru.expired(entry) {
+		lru.evicted(lru.removeNode(node))
+		return nil, false
+	}
+	return node, true
+}
+
+// Get returns the value of the key and marks it as the most recently used.
+func (lru *LRUCache) Get(key interface{}) (interface{}, bool) {
+	node, ok := lru.lookup(key)
+	if !ok {
+		return nil, false
+	}
+	lru.list.MoveToFront(node)
+	return node.Val.(*cacheEntry).value, true
+}
+
+// Peek returns the value of the key without marking it as used.
+func (lru *LRUCache) Peek(key interface{}) (interface{}, bool) {
+	node, ok := lru.items[key]
+	if !ok || lru.expired(node.Val.(*cacheEntry)) {
+		return nil, false
+	}
+	return node.Val.(*cacheEntry).value, true
+}
+
+// Put associates the value with the key and marks it as the most recently used, evicting the least recently used
+// entry if the cache is full. It returns true if the key is new to the cache.
+func (lru *LRUCache) Put(key, value interface{}) bool {
+	return lru.PutWithTTL(key, value, lru.ttl)
+}
+
+// PutWithTTL is the same as Put, but the entry expires after ttl instead of the TTL of the cache; 0 means never.
+func (lru *LRUCache) PutWithTTL(key, value interface{}, ttl time.Duration) bool {
+	if node, ok := lru.lookup(key); ok {
+		entry := node.Val.(*cacheEntry)
+		entry.value = value
+		entry.expireAt = lru.expireAt(ttl)
+		lru.list.MoveToFront(node)
+		return false
+	}
+	if len(lru.items) >= lru.capacity {
+		lru.evicted(lru.removeNode(lru.list.Back()))
+	}
+	lru.items[key] = lru.list.PushFront(&cacheEntry{key: key, value: value, expireAt: lru.expireAt(ttl)})
+	return true
+}
+
+// Remove removes the key, and returns false if the key does not exist.
+func (lru *LRUCache) Remove(key interface{}) bool {
+	node, ok := lru.items[key]
+	if ok {
+		lru.removeNode(node)
+	}
+	return ok
+}
+
+// Keys returns the keys from the most recently used to the least recently used, including the expired ones which
+// have not been removed yet.
+func (lru *LRUCache) Keys() []interface{} {
+	r := make([]interface{}, 0, len(lru.items))
+	for node := lru.list.Head.Next; node != lru.list.Head; node = node.Next {
+		r = append(r, node.Val.(*cacheEntry).key)
+	}
+	return r
+}
+
+// Purge removes all the expired entries, and returns the number of removed entries.
+func (lru *LRUCache) Purge() int {
+	count := 0
+	for node := lru.list.Head.Next; node != lru.list.Head; {
+		next := node.Next
+		if entry := node.Val.(*cacheEntry); lru.expired(entry) {
+			lru.evicted(lru.removeNode(node))
+			count ++
+		}
+		node = next
+	}
+	return count
+}
+
+// NewLRUCache returns an empty LRUCache object holding at most capacity entries.
+//
+// capacity must > 0; otherwise it will return nil.
+func NewLRUCache(capacity int) *LRUCache {
+	if capacity < 1 {
+		fmt.Println("the capacity must be > 0")
+		return nil
+	}
+	return &LRUCache{
+		cacheBase: cacheBase{capacity: capacity, now: time.Now},
+		items: make(map[interface{}]*BiNode),
+		list: NewDoubleLinkedList(nil),
+	}
+}
+
+// LFUCache
+//
+// The cache which evicts the least frequently used entry when it is full; among the entries used equally often,
+// the least recently used one is evicted. Please use NewLFUCache() as the safe constructor.
+//
+// It combines a map with a DoubleLinkedList for every frequency, so Get, Put, Peek and Remove cost O(1).
+// The keys must be comparable.
+//
+// It is not safe for concurrent use.
+type LFUCache struct {
+	cacheBase
+	items map[interface{}]*BiNode
+	lists map[int]*DoubleLinkedList  // the entries used freq times, from the most recently used
+	minFreq int  // the smallest frequency; may be stale after a removal, but then the cache is not full until Put resets it
+}
+
+// Len returns the number of entries, including the expired ones which have not been removed yet.
+func (lfu *LFUCache) Len() int {
+	return len(lfu.items)
+}
+
+// links the node into the list of its frequency.
+func (lfu *LFUCache) link(node *BiNode) {
+	freq := node.Val.(*cacheEntry).freq
+	list, ok := lfu.lists[freq]
+	if !ok {
+		list = NewDoubleLinkedList(nil)
+		lfu.lists[freq] = list
+	}
+	list.insertAfter(list.Head, node)
+}
+
+// unlinks the node from the list of its frequency, and drops the list if it becomes empty.
+func (lfu *LFUCache) unlink(node *BiNode) {
+	freq := node.Val.(*cacheEntry).freq
+	list := lfu.lists[freq]
+	list.DeleteNode(node)
+	if list.IsEmpty() {
+		delete(lfu.lists, freq)
+	}
+}
+
+// counts a use of the node.
+func (lfu *LFUCache) touch(node *BiNode) {
+	lfu.unlink(node)
+	entry := node.Val.(*cacheEntry)
+	if entry.freq == lfu.minFreq {
+		if _, ok := lfu.lists[entry.freq]; !ok {
+			lfu.minFreq ++
+		}
+	}
+	entry.freq ++
+	lfu.link(node)
+}
+
+// removes the node from the cache.
+func (lfu *LFUCache) removeNode(node *BiNode) *cacheEntry {
+	lfu.unlink(node)
+	entry := node.Val.(*cacheEntry)
+	delete(lfu.items, entry.key)
+	return entry
+}
+
+// returns the node of the key, and removes it if it has expired.
+func (lfu *LFUCache) lookup(key interface{}) (*BiNode, bool) {
+	node, ok := lfu.items[key]
+	if !ok {
+		return nil, false
+	}
+	if entry := node.Val.(*cacheEntry); lfu.expired(entry) {
+		lfu.evicted(lfu.removeNode(node))
+		return nil, false
+	}
+	return node, true
+}
+
+// evicts the least recently used entry among the least frequently used ones.
+func (lfu *LFUCache) evict() {
+	list := lfu.lists[lfu.minFreq]
+	lfu.evicted(lfu.removeNode(list.Back()))
+}
+
+// Get returns the value of the key and counts a use of it.
+func (lfu *LFUCache) Get(key interface{}) (interface{}, bool) {
+	node, ok := lfu.lookup(key)
+	if !ok {
+		return nil, false
+	}
+	lfu.touch(node)
+	return node.Val.(*cacheEntry).value, true
+}
+
+// Peek returns the value of the key without counting a use of it.
+func (lfu *LFUCache) Peek(key interface{}) (interface{}, bool) {
+	node, ok := lfu.items[key]
+	if !ok || lfu.expired(node.Val.(*cacheEntry)) {
+		return nil, false
+	}
+	return node.Val.(*cacheEntry).value, true
+}
+
+// Put associates the value with the key and counts a use of it, evicting the least frequently used entry if the
+// cache is full. It returns true if the key is new to the cache.
+func (lfu *LFUCache) Put(key, value interface{}) bool {
+	return lfu.PutWithTTL(key, value, lfu.ttl)
+}
+
+// PutWithTTL is the same as Put, but the entry expires after ttl instead of the TTL of the cache; 0 means never.
+func (lfu *LFUCache) PutWithTTL(key, value interface{}, ttl time.Duration) bool {
+	if node, ok := lfu.lookup(key); ok {
+		entry := node.Val.(*cacheEntry)
+		entry.value = value
+		entry.expireAt = lfu.expireAt(ttl)
+		lfu.touch(node)
+		return false
+	}
+	if len(lfu.items) >= lfu.capacity {
+		lfu.evict()
+	}
+	node := NewBiNode(&cacheEntry{key: key, value: value, expireAt: lfu.expireAt(ttl), freq: 1})
+	lfu.link(node)
+	lfu.items[key] = node
+	lfu.minFreq = 1
+	return true
+}
+
+// Remove removes the key, and returns false if the key does not exist.
+func (lfu *LFUCache) Remove(key interface{}) bool {
+	node, ok := lfu.items[key]
+	if ok {
+		lfu.removeNode(node)
+	}
+	return ok
+}
+
+// Frequency returns the number of uses of the key, and 0 if the key does not exist.
+func (lfu *LFUCache) Frequency(key interface{}) int {
+	if node, ok := lfu.items[key]; ok {
+		return node.Val.(*cacheEntry).freq
+	}
+	return 0
+}
+
+// Purge removes all the expired entries, and returns the number of removed entries.
+func (lfu *LFUCache) Purge() int {
+	count := 0
+	for _, node := range lfu.items {
+		if entry := node.Val.(*cacheEntry); lfu.expired(entry) {
+			lfu.evicted(lfu.removeNode(node))
+			count ++
+		}
+	}
+	return count
+}
+
+// NewLFUCache returns an empty LFUCache object holding at most capacity entries.
+//
+// capacity must > 0; otherwise it will return nil.
+func NewLFUCache(capacity int) *LFUCache {
+	if capacity < 1 {
+		fmt.Println("the capacity must be > 0")
+		return nil
+	}
+	return &LFUCache{
+		cacheBase: cacheBase{capacity: capacity, now: time.Now},
+		items: make(map[interface{}]*BiNode),
+		lists: make(map[int]*DoubleLinkedList),
+	}
+}
@@ -0,0 +1,53 @@
+package structures
+
+import (
+	"encoding/binary"
+	"io"
+	"some-data-structures/generics"
+)
+
+// Codec
+//
+// The interface for encoding and decoding interface{} values during serialization; see generics.Codec for details.
+//
+// e.g., call SetCodec(IntCodec) on a BTree storing int values before MarshalBinary or WriteTo.
+type Codec = generics.Codec[interface{}]
+
+// the built-in codecs for the structures storing interface{} values
+var (
+	IntCodec = generics.InterfaceCodec[int](generics.IntCodec{})
+	StringCodec = generics.InterfaceCodec[string](generics.StringCodec{})
+	Float64Codec = generics.InterfaceCodec[float64](generics.Float64Codec{})
+	VectorCodec Codec = vectorCodec{}
+)
+
+// vectorCodec encodes a *Vector as its dimension (8 bytes in big-endian) followed by the values of all dimensions.
+type vectorCodec struct{}
+
+func (vectorCodec) Encode(w io.Writer, val interface{}) error {
+	v := val.(*Vector)
+	if err := binary.Write(w, binary.BigEndian, uint64(len(v.v))); err != nil {
+		return err
+	}
+	return binary.Write(w, binary.BigEndian, v.v)
+}
+
+func (vectorCodec) Decode(r io.Reader) (interface{}, error) {
+	var d uint64
+	if err := binary.Read(r, binary.BigEndian, &d); err != nil {
+		return nil, err
+	}
+	// reads value by value, so that a corrupted dimension does not allocate a huge slice at once
+	v := make([]float64, 0)
+	for i := uint64(0); i < d; i ++ {
+		var x float64
+		if err := binary.Read(r, binary.BigEndian, &x); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+		v = append(v, x)
+	}
+	return &Vector{v: v}, nil
+}
@@ -0,0 +1,41 @@
+package structures
+
+import (
+	"some-data-structures/common"
+)
+
+// the adapter making BPlusTree a common.OrderedSet, which rejects duplicated values.
+type bPlusTreeSet struct {
+	*BPlusTree
+}
+
+func (bs bPlusTreeSet) Insert(val interface{}) bool {
+	if bs.Contains(val) {
+		return false
+	}
+	bs.BPlusTree.Insert(val)
+	return true
+}
+
+func (bs bPlusTreeSet) Range(lo, hi interface{}, inclusiveLo, inclusiveHi bool) []interface{} {
+	r := make([]interface{}, 0)
+	it := bs.BPlusTree.Range(lo, hi)
+	for v, ok := it.Next(); ok; v, ok = it.Next() {
+		if !inclusiveLo && lo != nil && bs.compare(v, lo) == 0 {  // nil is an unbounded lo
+			continue
+		}
+		if !inclusiveHi && hi != nil && bs.compare(v, hi) == 0 {
+			break
+		}
+		r = append(r, v)
+	}
+	return r
+}
+
+// AsOrderedSet returns a view of the tree as a common.OrderedSet; changes through the view are made to the tree.
+//
+// Different from BPlusTree.Insert, the Insert of the view does not insert a value which already exists; and
+// different from BPlusTree.Range, the Range of the view returns a slice instead of an iterator.
+func (bpt *BPlusTree) AsOrderedSet() common.OrderedSet {
+	return bPlusTreeSet{bpt}
+}
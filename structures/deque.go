@@ -0,0 +1,16 @@
+package structures
+
+import (
+	"some-data-structures/generics"
+)
+
+// Deque
+//
+// The double-ended queue structure storing interface{} values. Please use NewDeque() as the safe constructor.
+//
+// It is generics.Deque instantiated with interface{}; see generics.Deque for the methods.
+type Deque = generics.Deque[interface{}]
+
+func NewDeque() *Deque {
+	return generics.NewDeque[interface{}]()
+}
@@ -1 +1,195 @@
 package structures
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Fraction an exact rational number
+//
+// it implements the common.Value interface
+//
+// The numerator and the denominator are big integers; the fraction is always normalized, which means the
+// denominator is positive and the greatest common divisor of the numerator and the denominator is 1.
+//
+// To store Fractions in BinarySearchTree, RedBlackTree or BTree, pass CompareFraction as the compare method, which
+// simply calls Compare; Compare also accepts an int, so an int can be used directly for Search and Delete.
+//
+// The zero value is 0.
+type Fraction struct {
+	num *big.Int
+	den *big.Int
+}
+
+// returns the numerator & the denominator; the zero value of Fraction is 0 / 1.
+func (f *Fraction) parts() (*big.Int, *big.Int) {
+	if f.den == nil {
+		return new(big.Int), big.NewInt(1)
+	}
+	return f.num, f.den
+}
+
+// normalizes the sign and reduces the fraction by the greatest common divisor.
+//
+// den must not be 0.
+func (f *Fraction) normalize() {
+	if f.den.Sign() < 0 {
+		f.num.Neg(f.num)
+		f.den.Neg(f.den)
+	}
+	if f.num.Sign() == 0 {
+		f.den.SetInt64(1)
+		return
+	}
+	gcd := new(big.Int).GCD(nil, nil, new(big.Int).Abs(f.num), f.den)
+	if gcd.Cmp(big.NewInt(1)) != 0 {
+		f.num.Quo(f.num, gcd)
+		f.den.Quo(f.den, gcd)
+	}
+}
+
+// Num returns the numerator
+//
+// note that it returns a (deep) copy of the numerator
+func (f *Fraction) Num() *big.Int {
+	num, _ := f.parts()
+	return new(big.Int).Set(num)
+}
+
+// Den returns the denominator, which is always positive
+//
+// note that it returns a (deep) copy of the denominator
+func (f *Fraction) Den() *big.Int {
+	_, den := f.parts()
+	return new(big.Int).Set(den)
+}
+
+// IsZero checks if the fraction is 0
+func (f *Fraction) IsZero() bool {
+	num, _ := f.parts()
+	return num.Sign() == 0
+}
+
+// Negative returns the negative fraction
+//
+// e.g., x + (-x) = 0, then -x is the negative fraction of x
+func (f *Fraction) Negative() *Fraction {
+	num, den := f.parts()
+	return &Fraction{num: new(big.Int).Neg(num), den: new(big.Int).Set(den)}
+}
+
+// Add returns a new Fraction as the addition of 2 Fractions
+func (f *Fraction) Add(f2 *Fraction) *Fraction {
+	num1, den1 := f.parts()
+	num2, den2 := f2.parts()
+	num := new(big.Int).Mul(num1, den2)
+	num.Add(num, new(big.Int).Mul(num2, den1))
+	r := &Fraction{num: num, den: new(big.Int).Mul(den1, den2)}
+	r.normalize()
+	return r
+}
+
+// Minus returns a new Fraction as the difference between this Fraction f1 and the other Fraction f2 ( = f1 - f2)
+func (f *Fraction) Minus(f2 *Fraction) *Fraction {
+	return f.Add(f2.Negative())
+}
+
+// Multiple returns a new Fraction as the product of 2 Fractions
+func (f *Fraction) Multiple(f2 *Fraction) *Fraction {
+	num1, den1 := f.parts()
+	num2, den2 := f2.parts()
+	r := &Fraction{num: new(big.Int).Mul(num1, num2), den: new(big.Int).Mul(den1, den2)}
+	r.normalize()
+	return r
+}
+
+// Divide returns a new Fraction as the quotient of this Fraction f1 and the other Fraction f2 ( = f1 / f2)
+//
+// f2 must not be 0
+func (f *Fraction) Divide(f2 *Fraction) (*Fraction, bool) {
+	if f2.IsZero() {
+		fmt.Println("cannot divide by 0")
+		return nil, false
+	}
+	num1, den1 := f.parts()
+	num2, den2 := f2.parts()
+	r := &Fraction{num: new(big.Int).Mul(num1, den2), den: new(big.Int).Mul(den1, num2)}
+	r.normalize()
+	return r, true
+}
+
+// Compare compares this Fraction with f2, which can be a *Fraction or an int
+//
+// it returns 1 if f > f2 , 0 if f == f2, -1 if f < f2
+//
+// It works as the compare method of the trees through CompareFraction, e.g., NewBTree(2, CompareFraction).
+func (f *Fraction) Compare(f2 interface{}) int {
+	other, ok := f2.(*Fraction)
+	if !ok {
+		other = NewFraction(f2.(int), 1)
+	}
+	num1, den1 := f.parts()
+	num2, den2 := other.parts()
+	// the denominators are positive, so the cross multiplication keeps the order
+	return new(big.Int).Mul(num1, den2).Cmp(new(big.Int).Mul(num2, den1))
+}
+
+// Equal checks if the 2 fractions are equal
+func (f *Fraction) Equal(f2 *Fraction) bool {
+	num1, den1 := f.parts()
+	num2, den2 := f2.parts()
+	return num1.Cmp(num2) == 0 && den1.Cmp(den2) == 0
+}
+
+// Float64 returns the nearest float64 value of the fraction
+func (f *Fraction) Float64() float64 {
+	v, _ := new(big.Rat).SetFrac(f.parts()).Float64()
+	return v
+}
+
+// Copy makes a deep copy
+func (f *Fraction) Copy() interface{} {
+	num, den := f.parts()
+	return &Fraction{num: new(big.Int).Set(num), den: new(big.Int).Set(den)}
+}
+
+// String stringify
+//
+// e.g., "-3/4"; the denominator is omitted if it is 1
+func (f *Fraction) String() string {
+	num, den := f.parts()
+	if den.Cmp(big.NewInt(1)) == 0 {
+		return num.String()
+	}
+	return num.String() + "/" + den.String()
+}
+
+// CompareFraction is the compare method for using Fraction as Val in the trees; it calls a.Compare(b).
+//
+// a must be a *Fraction; b can be a *Fraction or an int, so an int can be used directly for Search and Delete.
+//
+// it returns 1 if a > b , 0 if a == b, -1 if a < b
+func CompareFraction(a, b interface{}) int {
+	return a.(*Fraction).Compare(b)
+}
+// NewFraction returns a new Fraction numerator / denominator
+//
+// denominator must not be 0; otherwise it will return nil.
+func NewFraction(numerator, denominator int) *Fraction {
+	return NewBigFraction(big.NewInt(int64(numerator)), big.NewInt(int64(denominator)))
+}
+
+// NewBigFraction returns a new Fraction numerator / denominator
+//
+// denominator must not be 0; otherwise it will return nil.
+//
+// NOTE: changes of numerator and denominator after this creation will not affect the value of the fraction
+func NewBigFraction(numerator, denominator *big.Int) *Fraction {
+	if denominator.Sign() == 0 {
+		fmt.Println("the denominator must not be 0")
+		return nil
+	}
+	f := &Fraction{num: new(big.Int).Set(numerator), den: new(big.Int).Set(denominator)}
+	f.normalize()
+	return f
+}
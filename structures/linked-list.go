@@ -1,55 +1,15 @@
 package structures
 
+import (
+	"some-data-structures/generics"
+)
+
 // LinkedList
 //
-// The basic linked list structure. Please use NewLinkedList() as the safe constructor.
-//
-// Attributes:
-//
-// Head *Node: the head of the linked list. Usually it is a dummy head.
-//
-// compare func(a, b interface{}) int: the compare method.
-type LinkedList struct {
-	Head *Node
-	compare func(a, b interface{}) int
-}
-
-// Search returns the first element satisfying the search condition.
+// The basic linked list structure storing interface{} values. Please use NewLinkedList() as the safe constructor.
 //
-// Will return nil if there is no such element.
-func (ll *LinkedList) Search(v interface{}) *Node {
-	pt := ll.Head
-	for pt.Next != nil {
-		pt = pt.Next
-		if ll.compare(pt.Val, v) == 0 {
-			return pt
-		}
-	}
-	return nil
-}
-
-// Insert inserts v as a new node to the head of this linked list.
-func (ll *LinkedList) Insert(v interface{}) {
-	node := NewNode(v)
-	node.Next = ll.Head.Next
-	ll.Head.Next = node
-}
-
-// Delete deletes and returns the first element satisfying the search condition.
-func (ll *LinkedList) Delete(v interface{}) *Node {
-	prev := ll.Head
-	cur := prev.Next
-	if cur != nil {
-		if ll.compare(cur.Val, v) == 0 {
-			prev.Next = cur.Next
-			cur.Next = nil
-			return cur
-		}
-		prev = cur
-		cur = cur.Next
-	}
-	return nil
-}
+// It is generics.LinkedList instantiated with interface{}; see generics.LinkedList for the methods.
+type LinkedList = generics.LinkedList[interface{}]
 
 // NewLinkedList returns a LinkedList object.
 //
@@ -57,7 +17,7 @@ func (ll *LinkedList) Delete(v interface{}) *Node {
 // it should return 1 if a > b , 0 if a == b, -1 if a < b;
 // a should always be an element from the struct other than user input.
 func NewLinkedList(compare func(a, b interface{}) int) *LinkedList {
-	return &LinkedList{Head: DummyNode(), compare: compare}
+	return generics.NewLinkedList(compare)
 }
 
 // DoubleLinkedList
@@ -66,21 +26,35 @@ func NewLinkedList(compare func(a, b interface{}) int) *LinkedList {
 //
 // Attributes:
 //
-// Head *BiNode: the head of the linked list. Usually it is a dummy head.
+// Head *BiNode: the sentinel of the linked list; Head.Next is the first node and Head.Prev is the last node.
 //
 // compare func(a, b interface{}) int: the compare method.
+//
+// .
+//
+// The end of the list is detected by the identity of the sentinel, so nil can be stored as a value.
+// The methods taking a node require the node to be in this list.
 type DoubleLinkedList struct {
 	Head *BiNode
+	n int
 	compare func(a, b interface{}) int
 }
 
+// Len returns the number of nodes, excluding the sentinel.
+func (dll *DoubleLinkedList) Len() int {
+	return dll.n
+}
+
+// IsEmpty returns true if the list has no nodes other than the sentinel.
+func (dll *DoubleLinkedList) IsEmpty() bool {
+	return dll.Head.Next == dll.Head
+}
+
 // Search returns the first element satisfying the search condition.
 //
 // Will return nil if there is no such element.
 func (dll *DoubleLinkedList) Search(v interface{}) *BiNode {
-	pt := dll.Head
-	for pt.Next.Val != nil {
-		pt = pt.Next
+	for pt := dll.Head.Next; pt != dll.Head; pt = pt.Next {
 		if dll.compare(pt.Val, v) == 0 {
 			return pt
 		}
@@ -88,16 +62,112 @@ func (dll *DoubleLinkedList) Search(v interface{}) *BiNode {
 	return nil
 }
 
+// links the node n after the node at.
+func (dll *DoubleLinkedList) insertAfter(at, n *BiNode) {
+	n.Prev = at
+	n.Next = at.Next
+	at.Next.Prev = n
+	at.Next = n
+	dll.n ++
+}
+
+// unlinks the node n.
+func (dll *DoubleLinkedList) unlink(n *BiNode) {
+	n.Prev.Next = n.Next
+	n.Next.Prev = n.Prev
+	n.Prev = nil
+	n.Next = nil
+	dll.n --
+}
+
 // Insert inserts v as a new node to the head of this linked list.
 func (dll *DoubleLinkedList) Insert(v interface{}) {
+	dll.PushFront(v)
+}
+
+// PushFront inserts v as a new node to the head of this linked list, and returns the new node.
+func (dll *DoubleLinkedList) PushFront(v interface{}) *BiNode {
+	node := NewBiNode(v)
+	dll.insertAfter(dll.Head, node)
+	return node
+}
+
+// PushBack inserts v as a new node to the tail of this linked list, and returns the new node.
+func (dll *DoubleLinkedList) PushBack(v interface{}) *BiNode {
+	node := NewBiNode(v)
+	dll.insertAfter(dll.Head.Prev, node)
+	return node
+}
+
+// InsertBefore inserts v as a new node before mark, and returns the new node.
+func (dll *DoubleLinkedList) InsertBefore(v interface{}, mark *BiNode) *BiNode {
 	node := NewBiNode(v)
-	node.Next = dll.Head.Next
-	dll.Head.Next.Prev = node
-	dll.Head.Next = node
-	node.Prev = dll.Head
+	dll.insertAfter(mark.Prev, node)
+	return node
+}
+
+// InsertAfter inserts v as a new node after mark, and returns the new node.
+func (dll *DoubleLinkedList) InsertAfter(v interface{}, mark *BiNode) *BiNode {
+	node := NewBiNode(v)
+	dll.insertAfter(mark, node)
+	return node
+}
+
+// Front returns the first node; will return nil if the list is empty.
+func (dll *DoubleLinkedList) Front() *BiNode {
+	if dll.Head.Next == dll.Head {
+		return nil
+	}
+	return dll.Head.Next
+}
+
+// Back returns the last node; will return nil if the list is empty.
+func (dll *DoubleLinkedList) Back() *BiNode {
+	if dll.Head.Prev == dll.Head {
+		return nil
+	}
+	return dll.Head.Prev
+}
+
+// PopFront removes and returns the first node; will return nil if the list is empty.
+func (dll *DoubleLinkedList) PopFront() *BiNode {
+	node := dll.Front()
+	if node != nil {
+		dll.unlink(node)
+	}
+	return node
+}
+
+// PopBack removes and returns the last node; will return nil if the list is empty.
+func (dll *DoubleLinkedList) PopBack() *BiNode {
+	node := dll.Back()
+	if node != nil {
+		dll.unlink(node)
+	}
+	return node
+}
+
+// MoveToFront moves the node to the head of the list.
+func (dll *DoubleLinkedList) MoveToFront(n *BiNode) {
+	if dll.Head.Next == n {
+		return
+	}
+	dll.unlink(n)
+	dll.insertAfter(dll.Head, n)
+}
+
+// MoveToBack moves the node to the tail of the list.
+func (dll *DoubleLinkedList) MoveToBack(n *BiNode) {
+	if dll.Head.Prev == n {
+		return
+	}
+	dll.unlink(n)
+	dll.insertAfter(dll.Head.Prev, n)
 }
 
 // Delete deletes and returns the first element satisfying the search condition.
+//
+// Will return nil if there is no such element.
 func (dll *DoubleLinkedList) Delete(v interface{}) *BiNode {
 	node := dll.Search(v)
 	dll.DeleteNode(node)
@@ -105,9 +175,22 @@ func (dll *DoubleLinkedList) Delete(v interface{}) *BiNode {
 }
 
 // DeleteNode deletes the node from the double linked list.
+//
+// It does nothing if n is nil or the sentinel.
 func (dll *DoubleLinkedList) DeleteNode(n *BiNode) {
-	n.Prev.Next = n.Next
-	n.Next.Prev = n.Prev
+	if n == nil || n == dll.Head {
+		return
+	}
+	dll.unlink(n)
+}
+
+// Values returns the values from the head to the tail.
+func (dll *DoubleLinkedList) Values() []interface{} {
+	r := make([]interface{}, 0, dll.n)
+	for pt := dll.Head.Next; pt != dll.Head; pt = pt.Next {
+		r = append(r, pt.Val)
+	}
+	return r
 }
 
 func NewDoubleLinkedList(compare func(a, b interface{}) int) *DoubleLinkedList {
@@ -116,3 +199,41 @@ func NewDoubleLinkedList(compare func(a, b interface{}) int) *DoubleLinkedList {
 	node.Next = node
 	return &DoubleLinkedList{Head: node, compare: compare}
 }
+
+// Iterator returns a cursor walking through the values from the head to the tail.
+//
+// The values are visited lazily; deleting the next node invalidates the cursor.
+func (dll *DoubleLinkedList) Iterator() *DoubleLinkedListIterator {
+	return &DoubleLinkedListIterator{sentinel: dll.Head, node: dll.Head.Next}
+}
+
+// ReverseIterator returns a cursor walking through the values from the tail to the head.
+//
+// The values are visited lazily; deleting the next node invalidates the cursor.
+func (dll *DoubleLinkedList) ReverseIterator() *DoubleLinkedListIterator {
+	return &DoubleLinkedListIterator{sentinel: dll.Head, node: dll.Head.Prev, reverse: true}
+}
+
+// DoubleLinkedListIterator
+//
+// The cursor over a DoubleLinkedList. Please use DoubleLinkedList.Iterator() or DoubleLinkedList.ReverseIterator()
+// to create one.
+type DoubleLinkedListIterator struct {
+	sentinel *BiNode
+	node *BiNode  // the next node to visit; will be the sentinel if there are no more values
+	reverse bool
+}
+
+// Next returns the next value and true, or nil and false if there are no more values.
+func (it *DoubleLinkedListIterator) Next() (interface{}, bool) {
+	if it.node == it.sentinel {
+		return nil, false
+	}
+	v := it.node.Val
+	if it.reverse {
+		it.node = it.node.Prev
+	} else {
+		it.node = it.node.Next
+	}
+	return v, true
+}
@@ -1,68 +1,29 @@
 package structures
 
+import (
+	"some-data-structures/generics"
+)
+
 // TreeNode
 //
-// The basic tree node.
-//
-// Attributes:
-//
-// Val interface{}: the value.
-//
-// Left *TreeNode: the smaller (or equal) left child.
-//
-// Right *TreeNode: the bigger right child.
-//
-// Parent *TreeNode: the parent node.
-type TreeNode struct {
-	Val interface{}
-	Left *TreeNode
-	Right *TreeNode
-	Parent *TreeNode
-}
+// The basic tree node storing an interface{} value; see generics.TreeNode for details.
+type TreeNode = generics.TreeNode[interface{}]
 
 func NewTreeNode(val interface{}) *TreeNode {
-	return &TreeNode{Val: val}
+	return generics.NewTreeNode(val)
 }
 
 // RBTreeNode
 //
-// The tree node for red-black-tree.
-//
-// Attributes:
-//
-// Val interface{}: the value.
-//
-// Color bool: if the node is red.
-//
-// Left *RBTreeNode: the smaller (or equal) left child.
-//
-// Right *RBTreeNode: the bigger right child.
-//
-// Parent *RBTreeNode: the parent node.
-type RBTreeNode struct {
-	Val   interface{}
-	Color bool
-	Left  *RBTreeNode
-	Right *RBTreeNode
-	Parent *RBTreeNode
-}
+// The tree node for red-black-tree storing an interface{} value; see generics.RBTreeNode for details.
+type RBTreeNode = generics.RBTreeNode[interface{}]
 
 func NewRBTreeNode(val interface{}, isRed bool) *RBTreeNode {
-	return &RBTreeNode{Val: val, Color: isRed}
+	return generics.NewRBTreeNode(val, isRed)
 }
 
-// BTreeNode The node used as the internal node & the leaf node for B tree
-//
-// Number of children of a node is equal to the number of keys in it plus 1.
-//
-// N int: the number of keys.
-type BTreeNode struct {
-	IsLeaf bool
-	N int
-	Keys []interface{}
-	Children []*BTreeNode
-	Parent *BTreeNode
-}
+// BTreeNode The node used as the internal node & the leaf node for B tree; see generics.BTreeNode for details.
+type BTreeNode = generics.BTreeNode[interface{}]
 
 // NewBTreeNode returns a new BTreeNode.
 //
@@ -72,33 +33,47 @@ type BTreeNode struct {
 //		Every node except root must contain at least t-1 keys. The root may contain minimum 1 key.
 //		All nodes (including root) may contain at most 2*t – 1 keys.
 //		t must > 1.
-//
-// Number of children of a node is equal to the number of keys in it plus 1.
 func NewBTreeNode(t int, isLeaf bool) *BTreeNode {
-	return &BTreeNode{IsLeaf: isLeaf, N : 0, Keys: make([]interface{}, 2 * t - 1), Children: make([]*BTreeNode, 2 * t)}
+	return generics.NewBTreeNode[interface{}](t, isLeaf)
 }
 
-// Node
+// BPlusTreeNode The node used as the internal node & the leaf node for B+ tree
 //
-// The basic node.
+// The values are only stored in the leaf nodes; the keys of an internal node are copies used as separators.
 //
-// Attributes:
+// N int: the number of keys.
 //
-// Val interface{}
+// Prev, Next *BPlusTreeNode: the neighboring leaf nodes; both are nil for an internal node.
+type BPlusTreeNode struct {
+	IsLeaf bool
+	N int
+	Keys []interface{}
+	Children []*BPlusTreeNode
+	Prev *BPlusTreeNode
+	Next *BPlusTreeNode
+}
+
+// NewBPlusTreeNode returns a new BPlusTreeNode.
 //
-// Next *Node  the child node
-type Node struct {
-	Val interface{}
-	Next *Node
+// t int
+//
+//		The minimum degree; see NewBTreeNode for details.
+func NewBPlusTreeNode(t int, isLeaf bool) *BPlusTreeNode {
+	return &BPlusTreeNode{IsLeaf: isLeaf, N : 0, Keys: make([]interface{}, 2 * t - 1), Children: make([]*BPlusTreeNode, 2 * t)}
 }
 
+// Node
+//
+// The basic node storing an interface{} value; see generics.Node for details.
+type Node = generics.Node[interface{}]
+
 // DummyNode returns a dummy node with val as nil
 func DummyNode() *Node {
-	return &Node{Val: nil}
+	return generics.DummyNode[interface{}]()
 }
 
 func NewNode(val interface{}) *Node {
-	return &Node{Val: val}
+	return generics.NewNode(val)
 }
 
 // BiNode
@@ -129,34 +104,20 @@ func NewBiNode(val interface{}) *BiNode {
 
 // FibNode
 //
-// The node structure for fibonacci heap.
-//
-// Attributes:
-//
-// Marked bool: if the node has lost a child node.
-//
-// Degree int: the number of the children of this node.
-//
-// Val interface{}
-//
-// Parent *FibNode: the parent.
-//
-// Child *FibNode: the child.
-//
-// Left *FibNode: the left sibling.
-//
-// Right *FibNode: the right sibling.
-type FibNode struct {
-	Marked bool  // false by default
-	Degree int  // 0 by default
-	Val interface{}
-	Parent *FibNode
-	Child *FibNode
-	Left *FibNode
-	Right *FibNode
-}
+// The node structure for fibonacci heap storing an interface{} value; see generics.FibNode for details.
+type FibNode = generics.FibNode[interface{}]
 
 // NewFibNode creates a new Fibonacci node object.
 func NewFibNode(val interface{}) *FibNode {
-	return &FibNode{Val: val}
-}
\ No newline at end of file
+	return generics.NewFibNode(val)
+}
+
+// PairingNode
+//
+// The node structure for pairing heap storing an interface{} value; see generics.PairingNode for details.
+type PairingNode = generics.PairingNode[interface{}]
+
+// BinomialNode
+//
+// The handle of an interface{} value in binomial heap; see generics.BinomialNode for details.
+type BinomialNode = generics.BinomialNode[interface{}]
@@ -0,0 +1,17 @@
+package structures
+
+import (
+	"some-data-structures/generics"
+)
+
+// PairingHeap
+//
+// The pairing heap structure storing interface{} values, which is an ordered min-heap.
+// Please use NewPairingHeap() as the safe constructor.
+//
+// It is generics.PairingHeap instantiated with interface{}; see generics.PairingHeap for the methods.
+type PairingHeap = generics.PairingHeap[interface{}]
+
+func NewPairingHeap(compare func(a, b interface{}) int) *PairingHeap {
+	return generics.NewPairingHeap(compare)
+}
@@ -1,77 +1,43 @@
 package structures
 
 import (
-	"some-data-structures/common"
+	"some-data-structures/generics"
 )
 
 // Queue
 //
-// The FIFO queue structure. Please use NewQueue() or NewQueueWithValues() as the safe constructor.
-type Queue struct {
-	queue []interface{}
-	head int  // points to the head of the queue
-	tail int  // points to the first empty slot after the end of the queue
-}
-
-func (q *Queue) Len() int {
-	return q.tail - q.head
-}
-
-func (q *Queue) HasNext() bool {
-	return q.tail > q.head
-}
+// The FIFO queue structure storing interface{} values. Please use NewQueue() as the safe constructor.
+//
+// It is generics.Queue instantiated with interface{}; see generics.Queue for the methods.
+type Queue = generics.Queue[interface{}]
 
-// Push pushes v into the queue.
-func (q *Queue) Push(v interface{}) {
-	if q.tail == len(q.queue) {
-		q.queue = append(q.queue, make([]interface{}, defaultSize)...)
-	}
-	q.queue[q.tail] = v
-	q.tail ++
+func NewQueue() *Queue {
+	return generics.NewQueue[interface{}]()
 }
 
-// Pop pops out and returns the first element of the queue.
-//
-// Please check if the queue is empty before using this method.
+// LockFreeQueue
 //
-// e.g.,
+// The FIFO queue storing interface{} values which is safe for concurrent use without locks.
+// Please use NewLockFreeQueue() as the safe constructor.
 //
-// if queue.HasNext() { queue.Pop() }
-func (q *Queue) Pop() interface{} {
-	if q.tail <= q.head {
-		return nil
-	}
-	pop := q.queue[q.head]
-	q.head ++
-	if q.head >= defaultSize {  // resize to save memory
-		q.queue = q.queue[q.head:]
-		q.tail -= q.head
-		q.head = 0
-	}
-	return pop
-}
+// It is generics.LockFreeQueue instantiated with interface{}; see generics.LockFreeQueue for the methods.
+type LockFreeQueue = generics.LockFreeQueue[interface{}]
 
-// Values returns the values in the queue.
-//
-// This is NOT a safe method, and you should avoid using it.
-func (q *Queue) Values() []interface{} {
-	return q.queue
+func NewLockFreeQueue() *LockFreeQueue {
+	return generics.NewLockFreeQueue[interface{}]()
 }
 
-// Empty completely empties the queue.
+// BlockingQueue
 //
-// Note: it does not empty the queue physically; if you want to release memory, please create a new Queue object instead.
-func (q *Queue) Empty() {
-	q.head = 0
-	q.tail = 0
-}
-
-// Copy makes a deep copy of the queue
-func (q *Queue) Copy() *Queue {
-	tmp := common.CopyInterfaces(q.queue)
-	return &Queue{queue: tmp, head: q.head, tail: q.tail}
-}
+// The bounded FIFO queue storing interface{} values which is safe for concurrent use.
+// Please use NewBlockingQueue() as the safe constructor.
+//
+// It is generics.BlockingQueue instantiated with interface{}; see generics.BlockingQueue for the methods.
+type BlockingQueue = generics.BlockingQueue[interface{}]
 
-func NewQueue() *Queue {
-	return &Queue{queue: make([]interface{}, defaultSize), head: 0, tail: 0}
+// NewBlockingQueue returns an empty BlockingQueue object holding at most capacity values.
+//
+// capacity must > 0; otherwise it will return nil.
+func NewBlockingQueue(capacity int) *BlockingQueue {
+	return generics.NewBlockingQueue[interface{}](capacity)
 }
@@ -0,0 +1,37 @@
+package structures
+
+import (
+	"some-data-structures/generics"
+)
+
+// Entry
+//
+// The key-value pair stored in TreeMap and BTreeMap.
+type Entry = generics.Entry[interface{}, interface{}]
+
+// TreeMap
+//
+// The ordered map built on RedBlackTree storing interface{} keys and values. Please use NewTreeMap() as
+// the safe constructor.
+//
+// It is generics.TreeMap instantiated with interface{}; see generics.TreeMap for the methods.
+type TreeMap = generics.TreeMap[interface{}, interface{}]
+
+// NewTreeMap returns an empty TreeMap object; compare only compares the keys.
+func NewTreeMap(compare func(a, b interface{}) int) *TreeMap {
+	return generics.NewTreeMap[interface{}, interface{}](compare)
+}
+
+// BTreeMap
+//
+// The ordered map built on BTree storing interface{} keys and values. Please use NewBTreeMap() as the safe constructor.
+//
+// It is generics.BTreeMap instantiated with interface{}; see generics.BTreeMap for the methods.
+type BTreeMap = generics.BTreeMap[interface{}, interface{}]
+
+// NewBTreeMap returns an empty BTreeMap object; compare only compares the keys.
+//
+// t must > 1; otherwise it will return nil.
+func NewBTreeMap(t int, compare func(a, b interface{}) int) *BTreeMap {
+	return generics.NewBTreeMap[interface{}, interface{}](t, compare)
+}
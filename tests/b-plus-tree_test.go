@@ -0,0 +1,169 @@
+package tests
+
+import (
+	"some-data-structures/structures"
+	"testing"
+)
+
+func TestBPlusTree(t *testing.T) {
+	nums := []int{1, 18, 2, 5, 19, 6, 7, 20, 21, 25, 12, 26, 10, 11, 22, 24, 13, 14, 15, 16, 17, 3, 4}
+	correct := []int{1, 2, 3, 4, 5, 6, 7, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 24, 25, 26}
+	bptree := structures.NewBPlusTree(3, compareInt)
+
+	// 1
+	if bptree.T() != 3 {
+		t.Errorf("BPlusTree1: wrong t")
+	}
+	for _, num := range nums {
+		bptree.Insert(num)
+	}
+	if n := bptree.NumOfElements(); n != len(nums) {
+		t.Errorf("BPlusTree1: wrong number of elements; expecting %d, got %d", len(nums), n)
+	}
+
+	// 2
+	node, index, b := bptree.Search(7)
+	if !b {
+		t.Errorf("BPlusTree2.1: fail to search")
+	}
+	if !node.IsLeaf || node.Keys[index].(int) != 7 {
+		t.Errorf("BPlusTree2.1: wrong search result")
+	}
+	node, index, b = bptree.Search(25)
+	if !b {
+		t.Errorf("BPlusTree2.2: fail to search")
+	}
+	if !node.IsLeaf || node.Keys[index].(int) != 25 {
+		t.Errorf("BPlusTree2.2: wrong search result")
+	}
+
+	values := bptree.Values()
+	for i, v := range correct {
+		if v != values[i] {
+			t.Errorf("BPlusTree2: wrong value; expecting %d, got %d", v, values[i])
+		}
+	}
+
+	// 3
+	correct = []int{1, 2, 3, 4, 5, 6, 7, 10, 11, 12, 13, 14, 15, 16, 18, 19, 20, 21, 22, 24, 25, 26}
+	bptree.Delete(17)
+	values = bptree.Values()
+	for i, v := range correct {
+		if v != values[i] {
+			t.Errorf("BPlusTree3.1: wrong value; expecting %d, got %d", v, values[i])
+		}
+	}
+
+	correct = []int{1, 2, 3, 4, 5, 6, 10, 11, 12, 13, 14, 15, 16, 18, 19, 20, 21, 22, 24, 25, 26}
+	bptree.Delete(7)
+	values = bptree.Values()
+	for i, v := range correct {
+		if v != values[i] {
+			t.Errorf("BPlusTree3.2: wrong value; expecting %d, got %d", v, values[i])
+		}
+	}
+
+	node, index, b = bptree.Search(7)
+	if b || node != nil || index != -1 {
+		t.Errorf("BPlusTree3: wrong search result")
+	}
+
+	if n := bptree.NumOfElements(); n != len(correct) {
+		t.Errorf("BPlusTree3: wrong number of elements; expecting %d, got %d", len(correct), n)
+	}
+
+	correct = []int{1, 2, 3, 4, 5, 6, 10, 11, 12, 13, 14, 15, 16, 18, 19, 21, 22, 24, 25, 26}
+	bptree.Delete(20)
+	values = bptree.Values()
+	for i, v := range correct {
+		if v != values[i] {
+			t.Errorf("BPlusTree3.3: wrong value; expecting %d, got %d", v, values[i])
+		}
+	}
+
+	correct = []int{1, 2, 3, 5, 6, 10, 11, 12, 13, 14, 15, 16, 18, 19, 21, 22, 24, 25, 26}
+	bptree.Delete(4)
+	values = bptree.Values()
+	for i, v := range correct {
+		if v != values[i] {
+			t.Errorf("BPlusTree3.4: wrong value; expecting %d, got %d", v, values[i])
+		}
+	}
+
+	correct = []int{1, 2, 3, 5, 6, 10, 11, 12, 13, 14, 15, 16, 18, 19, 21, 22, 24, 25, 25, 26}
+	bptree.Insert(25)
+	values = bptree.Values()
+	for i, v := range correct {
+		if v != values[i] {
+			t.Errorf("BPlusTree3.5: wrong value; expecting %d, got %d", v, values[i])
+		}
+	}
+
+	correct = []int{1, 2, 3, 5, 6, 10, 11, 12, 13, 14, 15, 16, 18, 19, 21, 22, 24, 25, 26}
+	bptree.Delete(25)
+	values = bptree.Values()
+	for i, v := range correct {
+		if v != values[i] {
+			t.Errorf("BPlusTree3.6: wrong value; expecting %d, got %d", v, values[i])
+		}
+	}
+
+	// 4 range
+	correct = []int{10, 11, 12, 13, 14, 15, 16, 18}
+	it := bptree.Range(7, 18)
+	i := 0
+	for v, ok := it.Next(); ok; v, ok = it.Next() {
+		if i >= len(correct) || v.(int) != correct[i] {
+			t.Errorf("BPlusTree4.1: wrong range value %d", v)
+		}
+		i ++
+	}
+	if i != len(correct) {
+		t.Errorf("BPlusTree4.1: wrong number of range values; expecting %d, got %d", len(correct), i)
+	}
+
+	correct = []int{22, 24, 25, 26}
+	it = bptree.Range(22, nil)
+	i = 0
+	for v, ok := it.Next(); ok; v, ok = it.Next() {
+		if i >= len(correct) || v.(int) != correct[i] {
+			t.Errorf("BPlusTree4.2: wrong range value %d", v)
+		}
+		i ++
+	}
+	if i != len(correct) {
+		t.Errorf("BPlusTree4.2: wrong number of range values; expecting %d, got %d", len(correct), i)
+	}
+
+	if _, ok := bptree.Range(100, nil).Next(); ok {
+		t.Errorf("BPlusTree4.3: expecting an empty range")
+	}
+
+	// 5 delete everything with the smallest minimum degree
+	bptree = structures.NewBPlusTree(2, compareInt)
+	for i := 0; i < 200; i ++ {
+		bptree.Insert((i * 37) % 100)  // every number in [0, 100) is inserted twice
+	}
+	for i := 0; i < 100; i ++ {
+		if !bptree.Delete(i) {
+			t.Errorf("BPlusTree5: fail to delete %d", i)
+		}
+		values = bptree.Values()
+		if len(values) != 199 - i {
+			t.Errorf("BPlusTree5: wrong number of values; expecting %d, got %d", 199 - i, len(values))
+		}
+		for j := 1; j < len(values); j ++ {
+			if values[j - 1].(int) > values[j].(int) {
+				t.Errorf("BPlusTree5: values are not in order")
+			}
+		}
+	}
+	for i := 99; i >= 0; i -- {
+		if !bptree.Delete(i) {
+			t.Errorf("BPlusTree5: fail to delete %d", i)
+		}
+	}
+	if bptree.Delete(0) || bptree.NumOfElements() != 0 || len(bptree.Values()) != 0 {
+		t.Errorf("BPlusTree5: the tree should be empty")
+	}
+}
@@ -0,0 +1,188 @@
+package tests
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"some-data-structures/generics"
+	"some-data-structures/structures"
+	"strconv"
+	"testing"
+)
+
+// checks the structure of the decoded tree against the original one
+func sameBTree(a, b *structures.BTreeNode, equal func(x, y interface{}) bool) bool {
+	if a.IsLeaf != b.IsLeaf || a.N != b.N {
+		return false
+	}
+	for i := 0; i < a.N; i ++ {
+		if !equal(a.Keys[i], b.Keys[i]) {
+			return false
+		}
+	}
+	if !a.IsLeaf {
+		for i := 0; i <= a.N; i ++ {
+			if b.Children[i].Parent != b || !sameBTree(a.Children[i], b.Children[i], equal) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestBTreeSerialization(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+	compareString := func(a, b interface{}) int {
+		if a.(string) > b.(string) {
+			return 1
+		} else if a.(string) == b.(string) {
+			return 0
+		}
+		return -1
+	}
+	compareFloat := func(a, b interface{}) int {
+		if a.(float64) > b.(float64) {
+			return 1
+		} else if a.(float64) == b.(float64) {
+			return 0
+		}
+		return -1
+	}
+	compareVector := func(a, b interface{}) int {
+		return compareFloat(a.(*structures.Vector).Magnitude(), b.(*structures.Vector).Magnitude())
+	}
+	equal := func(x, y interface{}) bool { return x == y }
+	equalVector := func(x, y interface{}) bool { return x.(*structures.Vector).Equal(y.(*structures.Vector)) }
+
+	cases := []struct {
+		name string
+		codec structures.Codec
+		compare func(a, b interface{}) int
+		equal func(x, y interface{}) bool
+		value func(i int) interface{}
+	}{
+		{"int", structures.IntCodec, compareInt, equal, func(i int) interface{} { return i * 37 % 1009 - 500 }},
+		{"string", structures.StringCodec, compareString, equal, func(i int) interface{} {
+			return string(rune('a' + r.Intn(26))) + strconv.Itoa(i) + string(make([]byte, r.Intn(5)))
+		}},
+		{"float64", structures.Float64Codec, compareFloat, equal, func(i int) interface{} { return r.NormFloat64() }},
+		{"vector", structures.VectorCodec, compareVector, equalVector, func(i int) interface{} {
+			return structures.NewVector([]float64{float64(i), r.Float64(), -r.Float64()})
+		}},
+	}
+
+	for _, c := range cases {
+		for _, degree := range []int{2, 3, 5} {
+			for _, n := range []int{0, 1, 10, 200} {
+				btree := structures.NewBTree(degree, c.compare)
+				btree.SetCodec(c.codec)
+				for i := 0; i < n; i ++ {
+					btree.Insert(c.value(i))
+				}
+
+				// 1 MarshalBinary & UnmarshalBinary
+				data, err := btree.MarshalBinary()
+				if err != nil {
+					t.Fatalf("TestBTreeSerialization1 %s: %v", c.name, err)
+				}
+				loaded := structures.NewBTree(2, c.compare)
+				loaded.SetCodec(c.codec)
+				if err = loaded.UnmarshalBinary(data); err != nil {
+					t.Fatalf("TestBTreeSerialization1 %s: %v", c.name, err)
+				}
+				if loaded.T() != degree || loaded.NumOfElements() != n || !sameBTree(btree.Root, loaded.Root, c.equal) {
+					t.Errorf("TestBTreeSerialization1 %s: the tree (t = %d, n = %d) is changed after a round trip", c.name, degree, n)
+				}
+
+				// 2 the loaded tree is still a valid B-tree
+				if n > 0 {
+					v := btree.Values()[n / 2]
+					if !loaded.Delete(v) || loaded.NumOfElements() != n - 1 {
+						t.Errorf("TestBTreeSerialization2 %s: fail to delete from the loaded tree", c.name)
+					}
+				}
+				loaded.Insert(c.value(n))
+
+				// 3 truncated data is rejected and leaves the tree unchanged
+				for _, cut := range []int{0, 1, len(data) / 2, len(data) - 1} {
+					if cut >= len(data) {
+						continue
+					}
+					tmp := structures.NewBTree(2, c.compare)
+					tmp.SetCodec(c.codec)
+					if err := tmp.UnmarshalBinary(data[:cut]); err == nil {
+						t.Errorf("TestBTreeSerialization3 %s: truncated data at %d should be rejected", c.name, cut)
+					}
+					if tmp.NumOfElements() != 0 || tmp.T() != 2 {
+						t.Errorf("TestBTreeSerialization3 %s: the tree should be unchanged", c.name)
+					}
+				}
+			}
+		}
+	}
+
+	// 4 WriteTo & ReadFrom keep the bytes after the tree
+	btree := structures.NewBTree(3, compareInt)
+	btree.SetCodec(structures.IntCodec)
+	for i := 0; i < 100; i ++ {
+		btree.Insert(i)
+	}
+	var buf bytes.Buffer
+	n, err := btree.WriteTo(&buf)
+	if err != nil || n != int64(buf.Len()) {
+		t.Errorf("TestBTreeSerialization4: wrote %d bytes with error %v, expected %d", n, err, buf.Len())
+	}
+	buf.WriteString("tail")
+	loaded := structures.NewBTree(2, compareInt)
+	loaded.SetCodec(structures.IntCodec)
+	if m, err := loaded.ReadFrom(&buf); err != nil || m != n {
+		t.Errorf("TestBTreeSerialization4: read %d bytes with error %v, expected %d", m, err, n)
+	}
+	if tail, _ := io.ReadAll(&buf); string(tail) != "tail" {
+		t.Errorf("TestBTreeSerialization4: expected the tail to remain, got %q", tail)
+	}
+	checkInts(t, "TestBTreeSerialization4", take(btree.Iterator(), -1), take(loaded.Iterator(), -1))
+
+	// 5 errors
+	if _, err := structures.NewBTree(2, compareInt).MarshalBinary(); err != generics.ErrNoCodec {
+		t.Errorf("TestBTreeSerialization5: expected ErrNoCodec, got %v", err)
+	}
+	if err := loaded.UnmarshalBinary([]byte{1, 0, 0, 0}); err != generics.ErrCorruptedData {
+		t.Errorf("TestBTreeSerialization5: t = 1 should be rejected, got %v", err)
+	}
+	if err := loaded.UnmarshalBinary([]byte{0x80, 0x80, 0x80, 0x80, 0x04, 0, 1, 0}); err != generics.ErrCorruptedData {
+		t.Errorf("TestBTreeSerialization5: t = 1 << 30 should be rejected, got %v", err)
+	}
+	data, _ := btree.MarshalBinary()
+	corrupted := append([]byte{0x81, 0x80, 0x04}, data[1:]...)  // t = 3 is replaced by 1 << 16 + 1
+	if err := loaded.UnmarshalBinary(corrupted); err != generics.ErrCorruptedData {
+		t.Errorf("TestBTreeSerialization5: t = 65537 should be rejected, got %v", err)
+	}
+	corrupted = append([]byte{0x80, 0x80, 0x01}, data[1:]...)  // t = 3 is replaced by 1 << 14
+	if err := loaded.UnmarshalBinary(corrupted); err != generics.ErrCorruptedData {
+		t.Errorf("TestBTreeSerialization5: a corrupted t should be rejected, got %v", err)
+	}
+	if loaded.T() != 3 {
+		t.Errorf("TestBTreeSerialization5: the tree should be unchanged, got t = %d", loaded.T())
+	}
+	checkInts(t, "TestBTreeSerialization5", take(btree.Iterator(), -1), take(loaded.Iterator(), -1))
+
+	// 6 generic trees
+	gtree := generics.NewBTree(2, compareIntT)
+	gtree.SetCodec(generics.IntCodec{})
+	for i := 0; i < 50; i ++ {
+		gtree.Insert(i * 7 % 50)
+	}
+	data, _ = gtree.MarshalBinary()
+	gloaded := generics.NewBTree(2, compareIntT)
+	gloaded.SetCodec(generics.IntCodec{})
+	if err := gloaded.UnmarshalBinary(data); err != nil {
+		t.Errorf("TestBTreeSerialization6: %v", err)
+	}
+	for i, v := range gloaded.Values() {
+		if v != i {
+			t.Errorf("TestBTreeSerialization6: expected %d, got %d", i, v)
+			break
+		}
+	}
+}
@@ -126,3 +126,41 @@ func TestBTree(t *testing.T) {
 		}
 	}
 }
+
+func TestBTreeRange(t *testing.T) {
+	nums := []int{1, 18, 2, 5, 19, 6, 7, 20, 21, 25, 12, 26, 10, 11, 22, 24, 13, 14, 15, 16, 17, 3, 4, 7, 20, 20}
+	sorted := []int{1, 2, 3, 4, 5, 6, 7, 7, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 20, 20, 21, 22, 24, 25, 26}
+	for _, degree := range []int{2, 3} {
+		btree := structures.NewBTree(degree, compareInt)
+		for _, num := range nums {
+			btree.Insert(num)
+		}
+		wrap := func(f func(v interface{}) (interface{}, bool)) func(v int) (interface{}, bool) {
+			return func(v int) (interface{}, bool) {
+				return f(v)
+			}
+		}
+		checkRangeQueries(t, "BTreeRange", sorted, func(lo, hi int, inclusiveLo, inclusiveHi bool) []interface{} {
+			return btree.Range(lo, hi, inclusiveLo, inclusiveHi)
+		}, wrap(btree.Floor), wrap(btree.Ceiling), wrap(btree.Lower), wrap(btree.Higher))
+	}
+}
+
+// deletes every value from large trees, which merges internal nodes many times
+func TestBTreeDeleteAll(t *testing.T) {
+	for _, degree := range []int{2, 3, 4} {
+		btree := structures.NewBTree(degree, compareInt)
+		n := 500
+		for i := 0; i < n; i ++ {
+			btree.Insert(i * 37 % n % 100)  // each value is inserted 5 times
+		}
+		for i := 0; i < n; i ++ {
+			if !btree.Delete(i % 100) {
+				t.Errorf("BTreeDeleteAll: t = %d, fail to delete %d", degree, i % 100)
+			}
+			if btree.NumOfElements() != n - i - 1 || len(btree.Values()) != n - i - 1 {
+				t.Errorf("BTreeDeleteAll: t = %d, wrong number of elements", degree)
+			}
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package tests
+
+import (
+	"reflect"
+	"some-data-structures/generics"
+	"some-data-structures/structures"
+	"testing"
+)
+
+func TestBinaryHeapGeneric(t *testing.T) {
+	testSlice := []int{16, 4, 10, 14, 7, 9, 3, 2, 8, 1}
+	compare := func(a, b int) int {
+		if a > b {
+			return 1
+		} else if a == b {
+			return 0
+		} else {
+			return -1
+		}
+	}
+
+	// 1
+	bh := generics.NewBinaryHeapWithValues(testSlice, compare)
+	if !reflect.DeepEqual(bh.Heap, []int{0, 16, 14, 10, 8, 7, 9, 3, 2, 4, 1}) {
+		t.Errorf("TestBinaryHeap1: wrong heap")
+	}
+
+	// 2
+	tmp := bh.Copy()
+	tmp.Heapsort()
+	if !reflect.DeepEqual(tmp.Heap, []int{0, 1, 2, 3, 4, 7, 8, 9, 10, 14, 16}) {
+		t.Errorf("TestBinaryHeap2: wrong heap")
+	}
+
+	// 3
+	val, err := bh.HeapMaximum()
+	if err != nil {
+		t.Error(err)
+	}
+	if val != 16 {
+		t.Errorf("TestBinaryHeap3: wrong maximum")
+	}
+	val, err = bh.ExtractHeapMaximum()
+	if err != nil {
+		t.Error(err)
+	}
+	if val != 16 {
+		t.Errorf("TestBinaryHeap3: wrong extracted maximum")
+	}
+
+	// 4
+	err = bh.Insert(20)
+	if err != nil {
+		t.Error(err)
+	}
+	err = bh.Insert(6)
+	if err != nil {
+		t.Error(err)
+	}
+	sorted := []int{20, 14, 10, 9, 8, 7, 6, 4, 3, 2, 1}
+	for i := 0; i < len(sorted); i ++ {
+		v, err := bh.ExtractHeapMaximum()
+		if err != nil {
+			t.Error(err)
+		}
+		if v != sorted[i] {
+			t.Errorf("TestBinaryHeap4: wrong extracted maximum")
+		}
+	}
+	_, err = bh.ExtractHeapMaximum()
+	if err == nil {
+		t.Errorf("TestBinaryHeap4: more values extracted than expected")
+	}
+
+	// 5 copy a heap of pointers
+	vh := generics.NewBinaryHeap(func(a, b *structures.Vector) int {
+		return compare(a.D(), b.D())
+	})
+	v := structures.NewVector([]float64{1, 2})
+	_ = vh.Insert(v)
+	vc := vh.Copy()
+	if vc.Size() != 1 || vc.Heap[1] == v || !vc.Heap[1].Equal(v) {
+		t.Errorf("TestBinaryHeap5: expected a deep copy of %v", v)
+	}
+}
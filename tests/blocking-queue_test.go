@@ -0,0 +1,159 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"some-data-structures/generics"
+	"some-data-structures/structures"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBlockingQueue(t *testing.T) {
+	ctx := context.Background()
+	if structures.NewBlockingQueue(0) != nil {
+		t.Errorf("TestBlockingQueue0: the capacity must be > 0")
+	}
+
+	// 1 non-blocking operations
+	bq := structures.NewBlockingQueue(2)
+	if !bq.TryPush(1) || !bq.TryPush(2) || bq.TryPush(3) {
+		t.Errorf("TestBlockingQueue1: only 2 values should be pushed")
+	}
+	if bq.Len() != 2 || bq.Cap() != 2 {
+		t.Errorf("TestBlockingQueue1: expected 2 values, got %d", bq.Len())
+	}
+	if v, b := bq.TryPop(); !b || v.(int) != 1 {
+		t.Errorf("TestBlockingQueue1: expected to pop 1, got %v", v)
+	}
+	if v, err := bq.Pop(ctx); err != nil || v.(int) != 2 {
+		t.Errorf("TestBlockingQueue1: expected to pop 2, got %v", v)
+	}
+	if _, b := bq.TryPop(); b {
+		t.Errorf("TestBlockingQueue1: the queue should be empty")
+	}
+
+	// 2 deadlines and cancellation
+	q := generics.NewBlockingQueue[int](1)
+	timeout, cancel := context.WithTimeout(ctx, 20 * time.Millisecond)
+	defer cancel()
+	if _, err := q.Pop(timeout); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("TestBlockingQueue2: Pop should time out, got %v", err)
+	}
+	_ = q.Push(ctx, 1)
+	canceled, cancel2 := context.WithCancel(ctx)
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel2()
+	}()
+	if err := q.Push(canceled, 2); !errors.Is(err, context.Canceled) {
+		t.Errorf("TestBlockingQueue2: Push should be canceled, got %v", err)
+	}
+	if q.Len() != 1 {
+		t.Errorf("TestBlockingQueue2: a canceled Push should not push the value")
+	}
+
+	// 3 a blocked Push is woken up by Pop
+	done := make(chan error)
+	go func() {
+		done <- q.Push(ctx, 3)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	if v, _ := q.Pop(ctx); v != 1 {
+		t.Errorf("TestBlockingQueue3: expected to pop 1, got %d", v)
+	}
+	if err := <-done; err != nil {
+		t.Errorf("TestBlockingQueue3: Push should succeed after Pop, got %v", err)
+	}
+	if v, _ := q.Pop(ctx); v != 3 {
+		t.Errorf("TestBlockingQueue3: expected to pop 3, got %d", v)
+	}
+
+	// 4 Close wakes up a blocked Pop; the values left can still be popped
+	go func() {
+		_, err := q.Pop(ctx)
+		done <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	if q.Close() != nil || q.Close() == nil || !q.IsClosed() {
+		t.Errorf("TestBlockingQueue4: the queue should be closed only once")
+	}
+	if err := <-done; !errors.Is(err, generics.ErrQueueClosed) {
+		t.Errorf("TestBlockingQueue4: Pop should fail after Close, got %v", err)
+	}
+	q2 := generics.NewBlockingQueue[int](3)
+	_ = q2.Push(ctx, 1)
+	_ = q2.Push(ctx, 2)
+	_ = q2.Close()
+	if err := q2.Push(ctx, 3); !errors.Is(err, generics.ErrQueueClosed) || q2.TryPush(3) {
+		t.Errorf("TestBlockingQueue4: Push should fail after Close, got %v", err)
+	}
+	if v, err := q2.Pop(ctx); err != nil || v != 1 {
+		t.Errorf("TestBlockingQueue4: expected to pop 1 after Close, got %d", v)
+	}
+	checkInts(t, "TestBlockingQueue4", []int{2}, q2.Drain())
+	if _, err := q2.Pop(ctx); !errors.Is(err, generics.ErrQueueClosed) {
+		t.Errorf("TestBlockingQueue4: Pop should fail on a closed & empty queue, got %v", err)
+	}
+}
+
+// run with "go test -race ./tests" to detect data races
+func TestBlockingQueueConcurrent(t *testing.T) {
+	// with capacity 1, every Push & Pop changes the queue between empty and full, so the wake-ups are exercised
+	for _, capacity := range []int{1, 8} {
+		checkBlockingQueueConcurrent(t, capacity)
+	}
+}
+
+func checkBlockingQueueConcurrent(t *testing.T, capacity int) {
+	producers := 4
+	perProducer := 1000
+	ctx := context.Background()
+	q := generics.NewBlockingQueue[int](capacity)
+
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p ++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < perProducer; i ++ {
+				if err := q.Push(ctx, i * producers + p); err != nil {
+					t.Errorf("TestBlockingQueueConcurrent: %v", err)
+					return
+				}
+			}
+		}(p)
+	}
+	results := make(chan []int)
+	for c := 0; c < producers; c ++ {
+		go func() {
+			r := make([]int, 0)
+			for {
+				v, err := q.Pop(ctx)
+				if err != nil {  // closed
+					results <- r
+					return
+				}
+				r = append(r, v)
+			}
+		}()
+	}
+	wg.Wait()
+	_ = q.Close()
+
+	seen := make([]bool, producers * perProducer)
+	count := 0
+	for c := 0; c < producers; c ++ {
+		for _, v := range <-results {
+			if seen[v] {
+				t.Fatalf("TestBlockingQueueConcurrent: %d is popped twice", v)
+			}
+			seen[v] = true
+			count ++
+		}
+	}
+	if count != producers * perProducer {
+		t.Errorf("TestBlockingQueueConcurrent: expected %d values, got %d", producers * perProducer, count)
+	}
+}
@@ -146,3 +146,24 @@ func TestBST(t *testing.T)  {
 		}
 	}
 }
+
+func TestBSTRange(t *testing.T) {
+	insertions := []int{10, 5, 15, 3, 8, 20, 0, 24, 8, 15, 11}
+	sorted := []int{0, 3, 5, 8, 8, 10, 11, 15, 15, 20, 24}
+	tree := structures.NewIntBSTree()
+	for _, num := range insertions {
+		tree.UnsafeInsert(num)
+	}
+	wrap := func(f func(v interface{}) (*structures.TreeNode, bool)) func(v int) (interface{}, bool) {
+		return func(v int) (interface{}, bool) {
+			node, b := f(v)
+			if !b {
+				return nil, false
+			}
+			return node.Val, true
+		}
+	}
+	checkRangeQueries(t, "BSTRange", sorted, func(lo, hi int, inclusiveLo, inclusiveHi bool) []interface{} {
+		return tree.Range(lo, hi, inclusiveLo, inclusiveHi)
+	}, wrap(tree.Floor), wrap(tree.Ceiling), wrap(tree.Lower), wrap(tree.Higher))
+}
@@ -0,0 +1,169 @@
+package tests
+
+import (
+	"some-data-structures/structures"
+	"testing"
+	"time"
+)
+
+// the clock which only moves when told to
+type fakeClock struct {
+	t time.Time
+}
+
+func (fc *fakeClock) now() time.Time {
+	return fc.t
+}
+
+func (fc *fakeClock) advance(d time.Duration) {
+	fc.t = fc.t.Add(d)
+}
+
+func cacheKeys(keys []interface{}) []int {
+	r := make([]int, len(keys))
+	for i, key := range keys {
+		r[i] = key.(int)
+	}
+	return r
+}
+
+func TestLRUCache(t *testing.T) {
+	if structures.NewLRUCache(0) != nil {
+		t.Errorf("TestLRUCache0: the capacity must be > 0")
+	}
+
+	// 1 evicts the least recently used entry
+	cache := structures.NewLRUCache(3)
+	evicted := make([]int, 0)
+	cache.SetOnEvict(func(key, value interface{}) {
+		evicted = append(evicted, key.(int))
+	})
+	for i := 1; i <= 3; i ++ {
+		if !cache.Put(i, i * 10) {
+			t.Errorf("TestLRUCache1: %d should be new", i)
+		}
+	}
+	if v, ok := cache.Get(1); !ok || v.(int) != 10 {
+		t.Errorf("TestLRUCache1: expected 10 for 1, got %v", v)
+	}
+	if v, ok := cache.Peek(2); !ok || v.(int) != 20 {  // does not mark 2 as used
+		t.Errorf("TestLRUCache1: expected 20 for 2, got %v", v)
+	}
+	cache.Put(4, 40)
+	checkInts(t, "TestLRUCache1 evicted", []int{2}, evicted)
+	checkInts(t, "TestLRUCache1 keys", []int{4, 1, 3}, cacheKeys(cache.Keys()))
+	if cache.Put(3, 31) || cache.Len() != 3 || cache.Cap() != 3 {
+		t.Errorf("TestLRUCache1: 3 should be updated")
+	}
+	checkInts(t, "TestLRUCache1 keys", []int{3, 4, 1}, cacheKeys(cache.Keys()))
+
+	// 2 Remove does not call the callback
+	if !cache.Remove(4) || cache.Remove(4) || cache.Len() != 2 {
+		t.Errorf("TestLRUCache2: 4 should be removed once")
+	}
+	if _, ok := cache.Get(4); ok {
+		t.Errorf("TestLRUCache2: 4 has been removed")
+	}
+	checkInts(t, "TestLRUCache2 evicted", []int{2}, evicted)
+
+	// 3 TTL
+	clock := &fakeClock{t: time.Unix(0, 0)}
+	cache = structures.NewLRUCache(3)
+	cache.SetClock(clock.now)
+	cache.SetTTL(time.Minute)
+	evicted = evicted[:0]
+	cache.SetOnEvict(func(key, value interface{}) {
+		evicted = append(evicted, key.(int))
+	})
+	cache.Put(1, 10)
+	cache.PutWithTTL(2, 20, 0)  // never expires
+	clock.advance(30 * time.Second)
+	cache.Put(3, 30)
+	clock.advance(30 * time.Second)
+	if _, ok := cache.Peek(1); ok {
+		t.Errorf("TestLRUCache3: 1 should have expired")
+	}
+	if _, ok := cache.Get(1); ok || cache.Len() != 2 {
+		t.Errorf("TestLRUCache3: 1 should be removed after expiry")
+	}
+	if _, ok := cache.Get(3); !ok {
+		t.Errorf("TestLRUCache3: 3 should not have expired")
+	}
+	clock.advance(time.Hour)
+	if n := cache.Purge(); n != 1 || cache.Len() != 1 {
+		t.Errorf("TestLRUCache3: expected to purge 1 entry, got %d", n)
+	}
+	if v, ok := cache.Get(2); !ok || v.(int) != 20 {
+		t.Errorf("TestLRUCache3: 2 should never expire")
+	}
+	checkInts(t, "TestLRUCache3 evicted", []int{1, 3}, evicted)
+}
+
+func TestLFUCache(t *testing.T) {
+	if structures.NewLFUCache(-1) != nil {
+		t.Errorf("TestLFUCache0: the capacity must be > 0")
+	}
+
+	// 1 evicts the least frequently used entry, and the least recently used one among the ties
+	cache := structures.NewLFUCache(3)
+	evicted := make([]int, 0)
+	cache.SetOnEvict(func(key, value interface{}) {
+		evicted = append(evicted, key.(int))
+	})
+	for i := 1; i <= 3; i ++ {
+		cache.Put(i, i * 10)
+	}
+	cache.Get(1)
+	cache.Get(1)
+	cache.Get(2)
+	cache.Peek(3)  // does not count
+	if cache.Frequency(1) != 3 || cache.Frequency(2) != 2 || cache.Frequency(3) != 1 {
+		t.Errorf("TestLFUCache1: wrong frequencies")
+	}
+	cache.Put(4, 40)  // evicts 3
+	cache.Put(5, 50)  // evicts 4, which is used once
+	checkInts(t, "TestLFUCache1 evicted", []int{3, 4}, evicted)
+	cache.Get(5)  // 2 and 5 are both used twice; 2 is less recently used
+	cache.Put(6, 60)
+	checkInts(t, "TestLFUCache1 evicted", []int{3, 4, 2}, evicted)
+	if cache.Put(6, 61) || cache.Frequency(6) != 2 {
+		t.Errorf("TestLFUCache1: Put on an existing key should count a use")
+	}
+
+	// 2 the smallest frequency is fixed after Remove
+	if !cache.Remove(5) || !cache.Remove(6) || cache.Remove(6) || cache.Len() != 1 {
+		t.Errorf("TestLFUCache2: wrong Remove")
+	}
+	cache.Put(7, 70)
+	cache.Get(7)
+	cache.Get(7)
+	cache.Get(7)
+	cache.Put(8, 80)
+	cache.Put(9, 90)  // evicts 8
+	checkInts(t, "TestLFUCache2 evicted", []int{3, 4, 2, 8}, evicted)
+	for _, key := range []int{1, 7, 9} {
+		if _, ok := cache.Peek(key); !ok {
+			t.Errorf("TestLFUCache2: %d should be in the cache", key)
+		}
+	}
+
+	// 3 TTL
+	clock := &fakeClock{t: time.Unix(0, 0)}
+	cache = structures.NewLFUCache(2)
+	cache.SetClock(clock.now)
+	cache.SetTTL(time.Second)
+	cache.Put(1, 10)
+	cache.PutWithTTL(2, 20, time.Minute)
+	clock.advance(time.Second)
+	if _, ok := cache.Get(1); ok || cache.Len() != 1 {
+		t.Errorf("TestLFUCache3: 1 should have expired")
+	}
+	clock.advance(time.Minute)
+	if n := cache.Purge(); n != 1 || cache.Len() != 0 {
+		t.Errorf("TestLFUCache3: expected to purge 1 entry, got %d", n)
+	}
+	cache.Put(3, 30)
+	if v, ok := cache.Get(3); !ok || v.(int) != 30 {
+		t.Errorf("TestLFUCache3: expected 30 for 3, got %v", v)
+	}
+}
@@ -1,5 +1,7 @@
 package tests
 
+import "testing"
+
 func compareInt (a, b interface{}) int {
 	if a.(int) > b.(int) {
 	return 1
@@ -8,3 +10,72 @@ func compareInt (a, b interface{}) int {
 	}
 	return -1
 }
+
+func compareIntT (a, b int) int {
+	if a > b {
+	return 1
+	} else if a == b {
+	return 0
+	}
+	return -1
+}
+
+// returns the values of the sorted slice between lo and hi
+func rangeOfSorted(sorted []int, lo, hi int, inclusiveLo, inclusiveHi bool) []int {
+	r := make([]int, 0)
+	for _, v := range sorted {
+		if (v > lo || (v == lo && inclusiveLo)) && (v < hi || (v == hi && inclusiveHi)) {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+// checks Range, Floor, Ceiling, Lower and Higher of an ordered structure against a sorted slice
+func checkRangeQueries(t *testing.T, name string, sorted []int, rangeOf func(lo, hi int, inclusiveLo, inclusiveHi bool) []interface{},
+	floor, ceiling, lower, higher func(v int) (interface{}, bool)) {
+	for lo := sorted[0] - 2; lo <= sorted[len(sorted) - 1] + 2; lo ++ {
+		// Floor, Ceiling, Lower and Higher
+		queries := []struct {
+			name string
+			f func(v int) (interface{}, bool)
+			match func(v int) bool
+			last bool
+		}{
+			{"Floor", floor, func(v int) bool { return v <= lo }, true},
+			{"Ceiling", ceiling, func(v int) bool { return v >= lo }, false},
+			{"Lower", lower, func(v int) bool { return v < lo }, true},
+			{"Higher", higher, func(v int) bool { return v > lo }, false},
+		}
+		for _, q := range queries {
+			expected, found := 0, false
+			for _, v := range sorted {
+				if q.match(v) && (!found || q.last) {
+					expected, found = v, true
+				}
+			}
+			v, b := q.f(lo)
+			if b != found || (b && v.(int) != expected) {
+				t.Errorf("%s: wrong %s of %d; expected %d (%t), got %v (%t)", name, q.name, lo, expected, found, v, b)
+			}
+		}
+
+		// Range
+		for hi := lo; hi <= sorted[len(sorted) - 1] + 2; hi += 3 {
+			for _, inclusive := range [][2]bool{{true, true}, {true, false}, {false, true}, {false, false}} {
+				expected := rangeOfSorted(sorted, lo, hi, inclusive[0], inclusive[1])
+				values := rangeOf(lo, hi, inclusive[0], inclusive[1])
+				if len(values) != len(expected) {
+					t.Errorf("%s: wrong range [%d, %d] %v; expected %v, got %v", name, lo, hi, inclusive, expected, values)
+					continue
+				}
+				for i, v := range expected {
+					if values[i].(int) != v {
+						t.Errorf("%s: wrong range [%d, %d] %v; expected %v, got %v", name, lo, hi, inclusive, expected, values)
+						break
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package tests
+
+import (
+	"some-data-structures/structures"
+	"sync"
+	"testing"
+)
+
+// run with "go test -race ./tests" to detect data races
+func TestConcurrentRedBlackTree(t *testing.T) {
+	writers := 8
+	perWriter := 500
+	tree := structures.NewConcurrentRedBlackTree(compareInt)
+
+	// 1 concurrent writers and readers
+	var wg sync.WaitGroup
+	for w := 0; w < writers; w ++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWriter; i ++ {
+				tree.Insert(i * writers + w)  // every writer inserts different values
+			}
+		}(w)
+	}
+	for r := 0; r < 4; r ++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 50; i ++ {
+				values := tree.InOrderTreeWalk()
+				for j := 1; j < len(values); j ++ {
+					if values[j - 1].(int) >= values[j].(int) {
+						t.Errorf("TestConcurrentRedBlackTree1: values are not in order")
+						return
+					}
+				}
+				if v, b := tree.Search(i); b && v.(int) != i {
+					t.Errorf("TestConcurrentRedBlackTree1: wrong search result")
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	n := writers * perWriter
+	if s := tree.Size(); s != n {
+		t.Errorf("TestConcurrentRedBlackTree1: expected size %d, got %d", n, s)
+	}
+	for i, v := range tree.InOrderTreeWalk() {
+		if v.(int) != i {
+			t.Errorf("TestConcurrentRedBlackTree1: expected %d, got %d", i, v)
+			break
+		}
+	}
+
+	// 2 snapshots are not affected by concurrent deletions
+	snapshot := tree.Snapshot()
+	for w := 0; w < writers; w ++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWriter; i ++ {
+				if i % 2 == 0 && !tree.Delete(i * writers + w) {
+					t.Errorf("TestConcurrentRedBlackTree2: fail to delete %d", i * writers + w)
+				}
+			}
+		}(w)
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		count := 0
+		for v, ok := snapshot.Next(); ok; v, ok = snapshot.Next() {
+			if v.(int) != count {
+				t.Errorf("TestConcurrentRedBlackTree2: expected %d in the snapshot, got %d", count, v)
+				return
+			}
+			count ++
+		}
+		if count != n {
+			t.Errorf("TestConcurrentRedBlackTree2: expected %d values in the snapshot, got %d", n, count)
+		}
+	}()
+	wg.Wait()
+
+	if s := tree.Size(); s != n / 2 {
+		t.Errorf("TestConcurrentRedBlackTree2: expected size %d, got %d", n / 2, s)
+	}
+	values := tree.Range(0, 4 * writers, true, false)
+	if len(values) != 2 * writers {
+		t.Errorf("TestConcurrentRedBlackTree2: expected %d values in range, got %d", 2 * writers, len(values))
+	}
+	for i, v := range values {
+		if expected := (i / writers * 2 + 1) * writers + i % writers; v.(int) != expected {
+			t.Errorf("TestConcurrentRedBlackTree2: expected %d in range, got %d", expected, v)
+		}
+	}
+}
@@ -0,0 +1,237 @@
+package tests
+
+import (
+	"math/rand"
+	"some-data-structures/common"
+	"some-data-structures/generics"
+	"some-data-structures/structures"
+	"sort"
+	"testing"
+)
+
+var (
+	_ common.BagOf[int] = generics.NewStack[int]()
+	_ common.BagOf[int] = generics.NewQueue[int]()
+	_ common.ContainerOf[int] = generics.NewLinkedList(compareIntT)
+	_ common.ContainerOf[int] = generics.NewPersistentRedBlackTree(compareIntT)
+	_ common.ContainerOf[int] = generics.NewTopK(3, compareIntT)
+	_ common.ContainerOf[int] = generics.NewTreeMap[string, int](nil)
+	_ common.ContainerOf[int] = generics.NewBTreeMap[string, int](2, nil)
+	_ common.ContainerOf[int] = &generics.DurableBTree[int]{}
+	_ common.OrderedSetOf[int] = generics.NewBSTree(compareIntT)
+	_ common.OrderedSetOf[int] = generics.NewRedBlackTree(compareIntT)
+	_ common.OrderedSetOf[int] = generics.NewConcurrentRedBlackTree(compareIntT)
+	_ common.Bag = structures.NewStack()
+	_ common.Bag = structures.NewQueue()
+	_ common.Container = structures.NewDoubleLinkedList(compareInt)
+	_ common.Container = structures.NewBPlusTree(2, compareInt)
+	_ common.OrderedSet = structures.NewRedBlackTree(compareInt)
+)
+
+// pops all the values from the queue
+func drainPriorityQueue[T any](pq common.PriorityQueueOf[T]) []T {
+	r := make([]T, 0, pq.Len())
+	for !pq.IsEmpty() {
+		v, _ := pq.Pop()
+		r = append(r, v)
+	}
+	return r
+}
+
+// runs random insertions & deletions on the set and compares it with a map
+func checkOrderedSet(t *testing.T, name string, set common.OrderedSetOf[int]) {
+	model := make(map[int]bool)
+	r := rand.New(rand.NewSource(19))
+	for i := 0; i < 2000; i ++ {
+		v := r.Intn(300)
+		if r.Intn(3) == 0 {
+			if set.Delete(v) != model[v] {
+				t.Fatalf("%s: Delete(%d) should return %v", name, v, model[v])
+			}
+			delete(model, v)
+		} else {
+			if set.Insert(v) == model[v] {
+				t.Fatalf("%s: Insert(%d) should return %v", name, v, !model[v])
+			}
+			model[v] = true
+		}
+	}
+	expected := make([]int, 0, len(model))
+	for v := range model {
+		expected = append(expected, v)
+	}
+	sort.Ints(expected)
+	if set.Len() != len(expected) || set.IsEmpty() != (len(expected) == 0) {
+		t.Errorf("%s: expected %d values, got %d", name, len(expected), set.Len())
+	}
+	checkInts(t, name, expected, set.Values())
+	for v := 0; v < 300; v ++ {
+		if set.Contains(v) != model[v] {
+			t.Errorf("%s: Contains(%d) should return %v", name, v, model[v])
+		}
+	}
+	for lo := -1; lo <= 300; lo += 37 {
+		for _, inclusive := range []bool{true, false} {
+			checkInts(t, name, rangeOfSorted(expected, lo, lo + 50, inclusive, !inclusive),
+				set.Range(lo, lo + 50, inclusive, !inclusive))
+		}
+	}
+}
+
+func TestOrderedSets(t *testing.T) {
+	checkOrderedSet(t, "TestOrderedSets BinarySearchTree", generics.NewBSTree(compareIntT))
+	checkOrderedSet(t, "TestOrderedSets RedBlackTree", generics.NewRedBlackTree(compareIntT))
+	checkOrderedSet(t, "TestOrderedSets ConcurrentRedBlackTree", generics.NewConcurrentRedBlackTree(compareIntT))
+	checkOrderedSet(t, "TestOrderedSets BTree", generics.NewBTree(3, compareIntT).AsOrderedSet())
+}
+
+func TestBPlusTreeAsOrderedSet(t *testing.T) {
+	set := structures.NewBPlusTree(3, compareInt).AsOrderedSet()
+	for _, v := range []int{5, 1, 9, 3, 7} {
+		if !set.Insert(v) {
+			t.Errorf("TestBPlusTreeAsOrderedSet: %d should be inserted", v)
+		}
+	}
+	if set.Insert(3) || set.Len() != 5 || !set.Contains(7) || set.Contains(4) {
+		t.Errorf("TestBPlusTreeAsOrderedSet: 3 should not be inserted again")
+	}
+	for _, c := range []struct {
+		lo, hi interface{}
+		inclusiveLo, inclusiveHi bool
+		expected []int
+	}{
+		{3, 7, true, true, []int{3, 5, 7}},
+		{3, 7, false, true, []int{5, 7}},
+		{3, 7, true, false, []int{3, 5}},
+		{3, 7, false, false, []int{5}},
+		{nil, 7, false, false, []int{1, 3, 5}},  // nil means unbounded
+		{3, nil, false, false, []int{5, 7, 9}},
+		{nil, nil, false, false, []int{1, 3, 5, 7, 9}},
+	} {
+		got := make([]int, 0)
+		for _, v := range set.Range(c.lo, c.hi, c.inclusiveLo, c.inclusiveHi) {
+			got = append(got, v.(int))
+		}
+		checkInts(t, "TestBPlusTreeAsOrderedSet", c.expected, got)
+	}
+}
+
+func TestPriorityQueues(t *testing.T) {
+	values := rand.New(rand.NewSource(19)).Perm(200)
+	ascending := make([]int, len(values))
+	copy(ascending, values)
+	sort.Ints(ascending)
+	descending := make([]int, len(ascending))
+	for i, v := range ascending {
+		descending[len(ascending) - 1 - i] = v
+	}
+
+	for _, c := range []struct {
+		name string
+		pq common.PriorityQueueOf[int]
+		expected []int
+	}{
+		{"PriorityQ", generics.NewPriorityQ(compareIntT).AsPriorityQueue(), ascending},
+		{"BinaryHeap", generics.NewBinaryHeap(compareIntT).AsPriorityQueue(), descending},
+		{"IndexedBinaryHeap", generics.NewIndexedBinaryHeap(compareIntT).AsPriorityQueue(), descending},
+		{"MinMaxHeap", generics.NewMinMaxHeap(compareIntT).AsPriorityQueue(), ascending},
+		{"FibonacciHeap", generics.NewFibonacciHeap(compareIntT).AsPriorityQueue(), ascending},
+		{"PairingHeap", generics.NewPairingHeap(compareIntT).AsPriorityQueue(), ascending},
+		{"BinomialHeap", generics.NewBinomialHeap(compareIntT).AsPriorityQueue(), ascending},
+	} {
+		name := "TestPriorityQueues " + c.name
+		if _, err := c.pq.Peek(); err == nil {
+			t.Errorf("%s: Peek should fail on an empty queue", name)
+		}
+		if _, err := c.pq.Pop(); err == nil {
+			t.Errorf("%s: Pop should fail on an empty queue", name)
+		}
+		for _, v := range values {
+			c.pq.Push(v)
+		}
+		got := c.pq.Values()
+		sort.Ints(got)
+		checkInts(t, name, ascending, got)
+		if v, err := c.pq.Peek(); err != nil || v != c.expected[0] || c.pq.Len() != len(values) {
+			t.Errorf("%s: expected to peek %d, got %d", name, c.expected[0], v)
+		}
+		checkInts(t, name, c.expected, drainPriorityQueue(c.pq))
+	}
+}
+
+func TestBags(t *testing.T) {
+	for _, c := range []struct {
+		name string
+		bag common.BagOf[int]
+		expected []int
+	}{
+		{"Stack", generics.NewStack[int](), []int{3, 2, 1}},
+		{"Queue", generics.NewQueue[int](), []int{1, 2, 3}},
+	} {
+		name := "TestBags " + c.name
+		if !c.bag.IsEmpty() || c.bag.HasNext() {
+			t.Errorf("%s: a new bag should be empty", name)
+		}
+		for _, v := range []int{1, 2, 3} {
+			c.bag.Push(v)
+		}
+		checkInts(t, name, []int{1, 2, 3}, c.bag.Values())
+		got := make([]int, 0)
+		for c.bag.HasNext() {
+			got = append(got, c.bag.Pop())
+		}
+		checkInts(t, name, c.expected, got)
+		if c.bag.Len() != 0 || len(c.bag.Values()) != 0 {
+			t.Errorf("%s: the bag should be empty", name)
+		}
+	}
+}
+
+func TestContainers(t *testing.T) {
+	ll := generics.NewLinkedList(compareIntT)
+	dll := structures.NewDoubleLinkedList(compareInt)
+	tm := generics.NewTreeMap[string, int](func(a, b string) int {
+		if a > b {
+			return 1
+		} else if a == b {
+			return 0
+		}
+		return -1
+	})
+	if !ll.IsEmpty() || !dll.IsEmpty() || !tm.IsEmpty() {
+		t.Errorf("TestContainers: the new containers should be empty")
+	}
+	for i, key := range []string{"c", "a", "b"} {
+		ll.Insert(i)
+		dll.Insert(i)
+		tm.Put(key, i)
+	}
+	checkInts(t, "TestContainers LinkedList", []int{2, 1, 0}, ll.Values())
+	got := make([]int, 0)
+	for _, v := range dll.Values() {
+		got = append(got, v.(int))
+	}
+	checkInts(t, "TestContainers DoubleLinkedList", []int{2, 1, 0}, got)
+	checkInts(t, "TestContainers TreeMap", []int{1, 2, 0}, tm.Values())
+	if ll.Len() != 3 || dll.Len() != 3 || tm.Len() != 3 {
+		t.Errorf("TestContainers: expected 3 values in each container")
+	}
+
+	// Len of BinarySearchTree is tracked by Insert & Delete
+	bst := generics.NewBSTree(compareIntT)
+	for _, v := range []int{5, 3, 8, 3, 1, 9} {
+		bst.Insert(v)
+	}
+	bst.UnsafeInsert(8)
+	bst.Delete(5)
+	bst.Delete(4)
+	if bst.Len() != len(bst.Values()) || bst.Len() != 5 {
+		t.Errorf("TestContainers: expected 5 values in the binary search tree, got %d", bst.Len())
+	}
+	for _, v := range bst.Values() {
+		bst.Delete(v)
+	}
+	if bst.Len() != 0 || !bst.IsEmpty() {
+		t.Errorf("TestContainers: the binary search tree should be empty")
+	}
+}
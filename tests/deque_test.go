@@ -0,0 +1,139 @@
+package tests
+
+import (
+	"math/rand"
+	"some-data-structures/generics"
+	"some-data-structures/structures"
+	"testing"
+)
+
+func TestDeque(t *testing.T) {
+	// 1 basic operations
+	dq := structures.NewDeque()
+	if _, err := dq.PopFront(); err == nil {
+		t.Errorf("TestDeque1: PopFront should fail on an empty deque")
+	}
+	if _, err := dq.PeekBack(); err == nil {
+		t.Errorf("TestDeque1: PeekBack should fail on an empty deque")
+	}
+	dq.PushBack(2)
+	dq.PushFront(1)
+	dq.PushBack(3)
+	if v, err := dq.PeekFront(); err != nil || v.(int) != 1 {
+		t.Errorf("TestDeque1: the front should be 1")
+	}
+	if v, err := dq.PeekBack(); err != nil || v.(int) != 3 {
+		t.Errorf("TestDeque1: the back should be 3")
+	}
+	if v, err := dq.At(1); err != nil || v.(int) != 2 {
+		t.Errorf("TestDeque1: the value at 1 should be 2")
+	}
+	if _, err := dq.At(3); err == nil {
+		t.Errorf("TestDeque1: index 3 should be invalid")
+	}
+	if dq.Set(1, 20) != nil || dq.Set(-1, 0) == nil {
+		t.Errorf("TestDeque1: failed to set the value at 1")
+	}
+	if v, _ := dq.PopBack(); v.(int) != 3 {
+		t.Errorf("TestDeque1: expected to pop 3 from the back")
+	}
+	if v, _ := dq.PopFront(); v.(int) != 1 {
+		t.Errorf("TestDeque1: expected to pop 1 from the front")
+	}
+	if v, _ := dq.PopFront(); v.(int) != 20 || !dq.IsEmpty() {
+		t.Errorf("TestDeque1: expected to pop 20 and then be empty")
+	}
+
+	// 2 random operations against a slice, so the buffer wraps around, grows and shrinks
+	gdq := generics.NewDeque[int]()
+	model := make([]int, 0)
+	r := rand.New(rand.NewSource(20))
+	for i := 0; i < 20000; i ++ {
+		// pushes more in the first half and pops more in the second half
+		push := r.Intn(10) < 6
+		if i >= 10000 {
+			push = r.Intn(10) < 4
+		}
+		front := r.Intn(2) == 0
+		switch {
+		case push && front:
+			gdq.PushFront(i)
+			model = append([]int{i}, model...)
+		case push:
+			gdq.PushBack(i)
+			model = append(model, i)
+		case len(model) == 0:
+			if _, err := gdq.PopBack(); err == nil {
+				t.Fatalf("TestDeque2: PopBack should fail on an empty deque")
+			}
+		case front:
+			if v, err := gdq.PopFront(); err != nil || v != model[0] {
+				t.Fatalf("TestDeque2 op %d: expected to pop %d from the front, got %d", i, model[0], v)
+			}
+			model = model[1:]
+		default:
+			if v, err := gdq.PopBack(); err != nil || v != model[len(model) - 1] {
+				t.Fatalf("TestDeque2 op %d: expected to pop %d from the back, got %d", i, model[len(model) - 1], v)
+			}
+			model = model[:len(model) - 1]
+		}
+		if gdq.Len() != len(model) {
+			t.Fatalf("TestDeque2 op %d: expected %d values, got %d", i, len(model), gdq.Len())
+		}
+		if i % 1000 == 0 {
+			checkInts(t, "TestDeque2", model, gdq.Values())
+			for j := range model {
+				if v, _ := gdq.At(j); v != model[j] {
+					t.Fatalf("TestDeque2 op %d: expected %d at %d, got %d", i, model[j], j, v)
+				}
+			}
+		}
+	}
+
+	// 3 copy & clear
+	cp := gdq.Copy()
+	gdq.Clear()
+	if !gdq.IsEmpty() || cp.Len() != len(model) {
+		t.Errorf("TestDeque3: the copy should not be affected by Clear")
+	}
+	checkInts(t, "TestDeque3", model, cp.Values())
+}
+
+func TestZeroValueDeque(t *testing.T) {
+	var dq generics.Deque[int]
+	if v, err := dq.PopBack(); err == nil || v != 0 || len(dq.Copy().Values()) != 0 {
+		t.Errorf("TestZeroValueDeque: an empty deque should have nothing to pop")
+	}
+	dq.PushFront(2)
+	dq.PushBack(3)
+	dq.PushFront(1)
+	checkInts(t, "TestZeroValueDeque", []int{1, 2, 3}, dq.Values())
+
+	var q structures.Queue
+	var sk structures.Stack
+	for i := 0; i < 40; i ++ {
+		q.Push(i)
+		sk.Push(i)
+	}
+	for i := 0; i < 40; i ++ {
+		if v := q.Pop(); v != i {
+			t.Errorf("TestZeroValueDeque: expected %d from the queue, got %v", i, v)
+		}
+		if v := sk.Pop(); v != 39 - i {
+			t.Errorf("TestZeroValueDeque: expected %d from the stack, got %v", 39 - i, v)
+		}
+	}
+	if !q.IsEmpty() || !sk.IsEmpty() {
+		t.Errorf("TestZeroValueDeque: the queue & the stack should be empty")
+	}
+}
+
+func BenchmarkDeque(b *testing.B) {
+	dq := generics.NewDeque[int]()
+	for i := 0; i < b.N; i ++ {
+		dq.PushBack(i)
+		if dq.Len() > 1000 {
+			_, _ = dq.PopFront()
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package tests
+
+import (
+	"math/rand"
+	"os"
+	"path/filepath"
+	"some-data-structures/structures"
+	"sort"
+	"testing"
+)
+
+func checkDurableBTree(t *testing.T, name string, tree *structures.DurableBTree, expected []int) {
+	if tree.NumOfElements() != len(expected) {
+		t.Errorf("%s: expected %d elements, got %d", name, len(expected), tree.NumOfElements())
+	}
+	values := tree.Values()
+	got := make([]int, len(values))
+	for i, v := range values {
+		got[i] = v.(int)
+	}
+	checkInts(t, name, expected, got)
+}
+
+// copies the files of a DurableBTree into a new directory
+func copyDurableBTree(t *testing.T, dir string) string {
+	target := t.TempDir()
+	entries, _ := os.ReadDir(dir)
+	for _, entry := range entries {
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = os.WriteFile(filepath.Join(target, entry.Name()), data, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return target
+}
+
+func TestDurableBTree(t *testing.T) {
+	dir := t.TempDir()
+	r := rand.New(rand.NewSource(12))
+	tree, err := structures.OpenDurableBTree(dir, 3, compareInt, structures.IntCodec)
+	if err != nil {
+		t.Fatalf("TestDurableBTree1: %v", err)
+	}
+
+	// 1 random operations; records the state & the size of the log after each operation
+	models := [][]int{{}}
+	sizes := []int64{0}
+	model := make([]int, 0)
+	for i := 0; i < 300; i ++ {
+		num := r.Intn(100)
+		if r.Intn(3) == 0 {
+			index := sort.SearchInts(model, num)
+			exists := index < len(model) && model[index] == num
+			if b, err := tree.Delete(num); err != nil || b != exists {
+				t.Fatalf("TestDurableBTree1: expected %v when deleting %d, got %v, %v", exists, num, b, err)
+			}
+			if !exists {
+				continue
+			}
+			model = append(model[:index], model[index + 1:]...)
+		} else {
+			if err := tree.Insert(num); err != nil {
+				t.Fatalf("TestDurableBTree1: %v", err)
+			}
+			model = append(model, num)
+			sort.Ints(model)
+		}
+		info, _ := os.Stat(filepath.Join(dir, "wal"))
+		models = append(models, append([]int{}, model...))
+		sizes = append(sizes, info.Size())
+	}
+	checkDurableBTree(t, "TestDurableBTree1", tree, model)
+	if err := tree.Close(); err != nil {
+		t.Errorf("TestDurableBTree1: %v", err)
+	}
+
+	// 2 replays the whole log
+	tree, err = structures.OpenDurableBTree(dir, 3, compareInt, structures.IntCodec)
+	if err != nil {
+		t.Fatalf("TestDurableBTree2: %v", err)
+	}
+	checkDurableBTree(t, "TestDurableBTree2", tree, model)
+	_ = tree.Close()
+
+	// 3 a crash leaves a torn record at the end of the log
+	for i := 0; i < 50; i ++ {
+		cut := r.Int63n(sizes[len(sizes) - 1] + 1)
+		crashed := copyDurableBTree(t, dir)
+		if err := os.Truncate(filepath.Join(crashed, "wal"), cut); err != nil {
+			t.Fatal(err)
+		}
+		k := sort.Search(len(sizes), func(j int) bool { return sizes[j] > cut }) - 1  // the last complete record
+		tree, err = structures.OpenDurableBTree(crashed, 3, compareInt, structures.IntCodec)
+		if err != nil {
+			t.Fatalf("TestDurableBTree3: %v", err)
+		}
+		checkDurableBTree(t, "TestDurableBTree3", tree, models[k])
+
+		// the torn record is dropped, so new records can be appended & replayed
+		_ = tree.Insert(1000)
+		_ = tree.Close()
+		tree, _ = structures.OpenDurableBTree(crashed, 3, compareInt, structures.IntCodec)
+		checkDurableBTree(t, "TestDurableBTree3", tree, append(append([]int{}, models[k]...), 1000))
+		_ = tree.Close()
+	}
+
+	// 4 a corrupted byte stops the replay at its record
+	corrupted := copyDurableBTree(t, dir)
+	data, _ := os.ReadFile(filepath.Join(corrupted, "wal"))
+	data[sizes[100] + 5] ^= 0xff
+	_ = os.WriteFile(filepath.Join(corrupted, "wal"), data, 0644)
+	tree, err = structures.OpenDurableBTree(corrupted, 3, compareInt, structures.IntCodec)
+	if err != nil {
+		t.Fatalf("TestDurableBTree4: %v", err)
+	}
+	checkDurableBTree(t, "TestDurableBTree4", tree, models[100])
+	_ = tree.Close()
+
+	// 5 checkpoint
+	tree, _ = structures.OpenDurableBTree(dir, 3, compareInt, structures.IntCodec)
+	oldLog := copyDurableBTree(t, dir)
+	if err := tree.Checkpoint(); err != nil {
+		t.Fatalf("TestDurableBTree5: %v", err)
+	}
+	if info, _ := os.Stat(filepath.Join(dir, "wal")); info.Size() != 0 {
+		t.Errorf("TestDurableBTree5: the log should be truncated, got %d bytes", info.Size())
+	}
+	for i := 0; i < 10; i ++ {
+		_ = tree.Insert(500 + i)
+		model = append(model, 500 + i)
+	}
+	_ = tree.Close()
+	tree, _ = structures.OpenDurableBTree(dir, 3, compareInt, structures.IntCodec)
+	checkDurableBTree(t, "TestDurableBTree5", tree, model)
+	_ = tree.Close()
+
+	// 6 a crash between writing the snapshot & truncating the log; the old records are not replayed twice
+	data, _ = os.ReadFile(filepath.Join(oldLog, "wal"))
+	_ = os.WriteFile(filepath.Join(dir, "wal"), data, 0644)
+	tree, err = structures.OpenDurableBTree(dir, 3, compareInt, structures.IntCodec)
+	if err != nil {
+		t.Fatalf("TestDurableBTree6: %v", err)
+	}
+	checkDurableBTree(t, "TestDurableBTree6", tree, model[:len(model) - 10])
+	_ = tree.Close()
+}
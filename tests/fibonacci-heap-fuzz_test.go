@@ -0,0 +1,141 @@
+package tests
+
+import (
+	"math/rand"
+	"some-data-structures/generics"
+	"sort"
+	"testing"
+)
+
+// an extracted or deleted node must be rejected by Delete & DecreaseKey without changing the heap
+func checkDetachedFibNode(t *testing.T, op int, heap *generics.FibonacciHeap[int], node *generics.FibNode[int]) {
+	if heap.Delete(node) == nil {
+		t.Fatalf("op %d: a detached node should not be deleted again", op)
+	}
+	if heap.DecreaseKey(node, node.Val - 1) == nil {
+		t.Fatalf("op %d: the key of a detached node should not be decreased", op)
+	}
+}
+
+// runs the operations encoded by data on a FibonacciHeap and on a sorted-slice model, and compares them after
+// every operation
+func checkFibonacciHeapOperations(t *testing.T, data []byte) {
+	heap := generics.NewFibonacciHeap(compareIntT)
+	nodes := make([]*generics.FibNode[int], 0)  // the nodes in the heap, which is also the model
+
+	sortedModel := func() []int {
+		r := make([]int, len(nodes))
+		for i, node := range nodes {
+			r[i] = node.Val
+		}
+		sort.Ints(r)
+		return r
+	}
+	removeNode := func(node *generics.FibNode[int]) {
+		for i := range nodes {
+			if nodes[i] == node {
+				nodes = append(nodes[:i], nodes[i + 1:]...)
+				return
+			}
+		}
+		t.Fatalf("node %d is not in the model", node.Val)
+	}
+
+	for i := 0; i + 1 < len(data); i += 2 {
+		op, v := data[i] % 6, int(data[i + 1])
+		switch {
+		case op == 0 || len(nodes) == 0:  // insert
+			nodes = append(nodes, heap.Insert(v))
+		case op == 1:  // extract the minimum
+			expected := sortedModel()[0]
+			node := heap.ExtractMin()
+			if node == nil || node.Val != expected {
+				t.Fatalf("op %d: expected to extract %d, got %v", i, expected, node)
+			}
+			removeNode(node)
+			checkDetachedFibNode(t, i, heap, node)
+		case op == 2:  // decrease a key
+			node := nodes[v % len(nodes)]
+			if err := heap.DecreaseKey(node, node.Val - v % 10); err != nil {
+				t.Fatalf("op %d: %v", i, err)
+			}
+			if heap.DecreaseKey(node, node.Val + 1) == nil {
+				t.Fatalf("op %d: a greater key should be rejected", i)
+			}
+		case op == 3:  // delete
+			node := nodes[v % len(nodes)]
+			if err := heap.Delete(node); err != nil {
+				t.Fatalf("op %d: %v", i, err)
+			}
+			removeNode(node)
+			checkDetachedFibNode(t, i, heap, node)
+		case op == 4:  // merge; both heaps are unchanged
+			other := generics.NewFibonacciHeap(compareIntT)
+			for j := 0; j < v % 4; j ++ {
+				other.Insert(v + j)
+			}
+			merged := heap.Merge(other)
+			expected := append(sortedModel(), other.Values()...)
+			sort.Ints(expected)
+			values := merged.Values()
+			sort.Ints(values)
+			checkInts(t, "merged values", expected, values)
+			if err := merged.Validate(); err != nil {
+				t.Fatalf("op %d: invalid merged heap: %v", i, err)
+			}
+			if other.Len() != v % 4 || other.Validate() != nil {
+				t.Fatalf("op %d: the other heap is changed by Merge", i)
+			}
+		default:  // union; the nodes are moved into the new heap
+			other := generics.NewFibonacciHeap(compareIntT)
+			nodes = append(nodes, other.Insert(v))
+			old := heap
+			heap = heap.Union(other)
+			if !old.IsEmpty() || !other.IsEmpty() || old.Minimum() != nil || other.Validate() != nil {
+				t.Fatalf("op %d: the old heaps should be empty after Union", i)
+			}
+		}
+
+		if err := heap.Validate(); err != nil {
+			t.Fatalf("op %d: invalid heap: %v", i, err)
+		}
+		if heap.Len() != len(nodes) || heap.IsEmpty() != (len(nodes) == 0) {
+			t.Fatalf("op %d: expected %d nodes, got %d", i, len(nodes), heap.Len())
+		}
+		if len(nodes) > 0 && heap.Minimum().Val != sortedModel()[0] {
+			t.Fatalf("op %d: expected minimum %d, got %d", i, sortedModel()[0], heap.Minimum().Val)
+		}
+		values := heap.Values()
+		sort.Ints(values)
+		checkInts(t, "values", sortedModel(), values)
+	}
+
+	// extracts everything in order
+	expected := sortedModel()
+	got := make([]int, 0)
+	for !heap.IsEmpty() {
+		got = append(got, heap.ExtractMin().Val)
+	}
+	checkInts(t, "extracted values", expected, got)
+	if heap.ExtractMin() != nil || heap.Delete(nil) == nil {
+		t.Fatalf("the heap should be empty")
+	}
+}
+
+// go test -fuzz FuzzFibonacciHeap ./tests
+func FuzzFibonacciHeap(f *testing.F) {
+	f.Add([]byte{0, 5, 0, 3, 0, 9, 1, 0, 2, 1, 3, 0})
+	f.Add([]byte{5, 1, 5, 2, 4, 3, 1, 1, 3, 1})
+	r := rand.New(rand.NewSource(17))
+	for i := 0; i < 5; i ++ {
+		data := make([]byte, 2000)
+		for j := range data {
+			data[j] = byte(r.Intn(256))
+			if j % 2 == 0 && r.Intn(2) == 0 {
+				data[j] = 0  // more insertions, so that the heap grows
+			}
+		}
+		f.Add(data)
+	}
+	f.Fuzz(checkFibonacciHeapOperations)
+}
@@ -55,7 +55,7 @@ func TestFibonacciHeap(t *testing.T) {
 
 	for i := 0; i < 10; i ++ {
 		node := m[sorted[index]]
-		err := fh.Delete(node, -1)
+		err := fh.Delete(node)
 		if err != nil {
 			t.Errorf("TestFibonacciHeap1: wrong deletion")
 		}
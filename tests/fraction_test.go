@@ -0,0 +1,120 @@
+package tests
+
+import (
+	"math/big"
+	"some-data-structures/structures"
+	"testing"
+)
+
+func TestFraction(t *testing.T) {
+	// 1 normalization
+	f1 := structures.NewFraction(6, -8)
+	if s := f1.String(); s != "-3/4" {
+		t.Errorf("TestFraction1: expected -3/4, got %s", s)
+	}
+	if f1.Den().Sign() != 1 {
+		t.Errorf("TestFraction1: the denominator should be positive")
+	}
+	if s := structures.NewFraction(0, -5).String(); s != "0" {
+		t.Errorf("TestFraction1: expected 0, got %s", s)
+	}
+	if structures.NewFraction(1, 0) != nil {
+		t.Errorf("TestFraction1: a zero denominator should be rejected")
+	}
+	big1 := big.NewInt(10)
+	f2 := structures.NewBigFraction(big1, big.NewInt(4))
+	big1.SetInt64(100)
+	if s := f2.String(); s != "5/2" {
+		t.Errorf("TestFraction1: changed fraction value; expected 5/2, got %s", s)
+	}
+
+	// 2 arithmetic
+	if s := f1.Add(f2).String(); s != "7/4" {
+		t.Errorf("TestFraction2: wrong addition; expected 7/4, got %s", s)
+	}
+	if s := f1.Minus(f2).String(); s != "-13/4" {
+		t.Errorf("TestFraction2: wrong subtraction; expected -13/4, got %s", s)
+	}
+	if s := f1.Multiple(f2).String(); s != "-15/8" {
+		t.Errorf("TestFraction2: wrong multiplication; expected -15/8, got %s", s)
+	}
+	if d, b := f1.Divide(f2); !b || d.String() != "-3/10" {
+		t.Errorf("TestFraction2: wrong division")
+	}
+	if _, b := f1.Divide(structures.NewFraction(0, 1)); b {
+		t.Errorf("TestFraction2: division by 0 should fail")
+	}
+	if !f1.Add(f1.Negative()).IsZero() {
+		t.Errorf("TestFraction2: wrong negative fraction")
+	}
+	if f := f1.Float64(); f != -0.75 {
+		t.Errorf("TestFraction2: expected -0.75, got %f", f)
+	}
+
+	// 3 compare & copy
+	if f1.Compare(f2) != -1 || f2.Compare(f1) != 1 || f1.Compare(structures.NewFraction(-6, 8)) != 0 {
+		t.Errorf("TestFraction3: wrong compare result")
+	}
+	cpy := f1.Copy().(*structures.Fraction)
+	if !cpy.Equal(f1) || cpy == f1 {
+		t.Errorf("TestFraction3: wrong copy")
+	}
+
+	// 4 as Val in the trees
+	insertions := []*structures.Fraction{
+		structures.NewFraction(1, 2), structures.NewFraction(1, 3), structures.NewFraction(3, 1),
+		structures.NewFraction(-2, 3), structures.NewFraction(2, 4), structures.NewFraction(5, 7),
+	}
+	correct := []string{"-2/3", "1/3", "1/2", "5/7", "3"}
+
+	bst := structures.NewBSTree(structures.CompareFraction)
+	rbt := structures.NewRedBlackTree(structures.CompareFraction)
+	btree := structures.NewBTree(2, structures.CompareFraction)
+	for _, f := range insertions {
+		bst.Insert(f)
+		rbt.Insert(f)
+		if _, _, b := btree.Search(f); !b {
+			btree.Insert(f)
+		}
+	}
+	for name, values := range map[string][]interface{}{
+		"bst": bst.InOrderTreeWalk(), "rbt": rbt.InOrderTreeWalk(), "btree": btree.Values(),
+	} {
+		if len(values) != len(correct) {
+			t.Errorf("TestFraction4 %s: expected %d values, got %d", name, len(correct), len(values))
+			continue
+		}
+		for i, v := range values {
+			if s := v.(*structures.Fraction).String(); s != correct[i] {
+				t.Errorf("TestFraction4 %s: expected %s, got %s", name, correct[i], s)
+			}
+		}
+	}
+
+	if _, b := rbt.Search(3); !b {
+		t.Errorf("TestFraction4: fail to search an int")
+	}
+	if !bst.Delete(3) || !rbt.Delete(structures.NewFraction(6, 2)) || !btree.Delete(3) {
+		t.Errorf("TestFraction4: fail to delete")
+	}
+}
+
+func TestFractionZeroValue(t *testing.T) {
+	var zero structures.Fraction
+	if !zero.IsZero() || zero.String() != "0" || zero.Float64() != 0 || zero.Den().Int64() != 1 {
+		t.Errorf("TestFractionZeroValue: the zero value should be 0, got %s", zero.String())
+	}
+	half := structures.NewFraction(1, 2)
+	if zero.Compare(half) != -1 || half.Compare(&zero) != 1 || zero.Compare(0) != 0 || !zero.Equal(structures.NewFraction(0, 5)) {
+		t.Errorf("TestFractionZeroValue: wrong compare result")
+	}
+	if s := zero.Add(half).String(); s != "1/2" {
+		t.Errorf("TestFractionZeroValue: expected 1/2, got %s", s)
+	}
+	if _, b := half.Divide(&zero); b {
+		t.Errorf("TestFractionZeroValue: division by the zero value should fail")
+	}
+	if half.Compare(1) != -1 || structures.NewFraction(4, 2).Compare(2) != 0 {
+		t.Errorf("TestFractionZeroValue: wrong compare result with an int")
+	}
+}
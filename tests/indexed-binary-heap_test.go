@@ -0,0 +1,99 @@
+package tests
+
+import (
+	"math/rand"
+	"some-data-structures/generics"
+	"some-data-structures/structures"
+	"sort"
+	"testing"
+)
+
+func TestIndexedBinaryHeap(t *testing.T) {
+	// 1 a min heap with random updates & removals, compared with a sorted slice
+	r := rand.New(rand.NewSource(13))
+	heap := structures.NewIndexedBinaryHeap(func(a, b interface{}) int { return -compareInt(a, b) })
+	items := make([]*structures.HeapItem, 0)
+	for i := 0; i < 1000; i ++ {
+		items = append(items, heap.Insert(r.Intn(1000)))
+	}
+	for i := 0; i < 500; i ++ {
+		item := items[r.Intn(len(items))]
+		if !heap.Contains(item) {
+			continue
+		}
+		if r.Intn(2) == 0 {
+			if err := heap.UpdateKey(item, r.Intn(1000)); err != nil {
+				t.Errorf("TestIndexedBinaryHeap1: %v", err)
+			}
+		} else {
+			if err := heap.Remove(item); err != nil || heap.Contains(item) {
+				t.Errorf("TestIndexedBinaryHeap1: fail to remove")
+			}
+			if heap.Remove(item) == nil || heap.UpdateKey(item, 0) == nil {
+				t.Errorf("TestIndexedBinaryHeap1: a removed item should be rejected")
+			}
+		}
+	}
+	expected := make([]int, 0)
+	for _, item := range items {
+		if heap.Contains(item) {
+			expected = append(expected, item.Val.(int))
+		}
+	}
+	sort.Ints(expected)
+	if heap.Size() != len(expected) {
+		t.Errorf("TestIndexedBinaryHeap1: expected size %d, got %d", len(expected), heap.Size())
+	}
+	got := make([]int, 0)
+	for heap.Size() > 0 {
+		top, _ := heap.HeapMaximum()
+		item, err := heap.ExtractHeapMaximum()
+		if err != nil || item != top || heap.Contains(item) {
+			t.Errorf("TestIndexedBinaryHeap1: wrong extraction")
+		}
+		got = append(got, item.Val.(int))
+	}
+	checkInts(t, "TestIndexedBinaryHeap1", expected, got)
+	if _, err := heap.ExtractHeapMaximum(); err == nil {
+		t.Errorf("TestIndexedBinaryHeap1: the heap should be empty")
+	}
+	if other := structures.NewIndexedBinaryHeap(compareInt); other.Contains(heap.Insert(1)) {
+		t.Errorf("TestIndexedBinaryHeap1: an item should only belong to its own heap")
+	}
+
+	// 2 dijkstra with decrease-key
+	type vertex struct {
+		id int
+		dist int
+	}
+	edges := [][][2]int{  // {to, weight}
+		{{1, 7}, {2, 9}, {5, 14}},
+		{{0, 7}, {2, 10}, {3, 15}},
+		{{0, 9}, {1, 10}, {3, 11}, {5, 2}},
+		{{1, 15}, {2, 11}, {4, 6}},
+		{{3, 6}, {5, 9}},
+		{{0, 14}, {2, 2}, {4, 9}},
+	}
+	const inf = 1 << 30
+	pq := generics.NewIndexedBinaryHeap(func(a, b vertex) int { return -compareIntT(a.dist, b.dist) })
+	handles := make([]*generics.HeapItem[vertex], len(edges))
+	for i := range edges {
+		dist := inf
+		if i == 0 {
+			dist = 0
+		}
+		handles[i] = pq.Insert(vertex{i, dist})
+	}
+	dist := make([]int, len(edges))
+	for pq.Size() > 0 {
+		item, _ := pq.ExtractHeapMaximum()
+		u := item.Val
+		dist[u.id] = u.dist
+		for _, e := range edges[u.id] {
+			if h := handles[e[0]]; pq.Contains(h) && u.dist + e[1] < h.Val.dist {
+				_ = pq.UpdateKey(h, vertex{e[0], u.dist + e[1]})
+			}
+		}
+	}
+	checkInts(t, "TestIndexedBinaryHeap2", []int{0, 7, 9, 20, 20, 11}, dist)
+}
@@ -0,0 +1,106 @@
+package tests
+
+import (
+	"some-data-structures/common"
+	"some-data-structures/structures"
+	"testing"
+)
+
+// takes at most n values from the iterator; n < 0 means taking all the values
+func take(it common.Iterator, n int) []int {
+	r := make([]int, 0)
+	for n < 0 || len(r) < n {
+		v, ok := it.Next()
+		if !ok {
+			break
+		}
+		r = append(r, v.(int))
+	}
+	return r
+}
+
+func checkInts(t *testing.T, name string, expected, got []int) {
+	if len(expected) != len(got) {
+		t.Errorf("%s: expected %v, got %v", name, expected, got)
+		return
+	}
+	for i := range expected {
+		if expected[i] != got[i] {
+			t.Errorf("%s: expected %v, got %v", name, expected, got)
+			return
+		}
+	}
+}
+
+func TestIterators(t *testing.T) {
+	nums := []int{16, 3, 7, 11, 9, 26, 18, 14, 15, 1}
+	ascending := []int{1, 3, 7, 9, 11, 14, 15, 16, 18, 26}
+	descending := []int{26, 18, 16, 15, 14, 11, 9, 7, 3, 1}
+
+	bst := structures.NewIntBSTree()
+	rbt := structures.NewRedBlackTree(compareInt)
+	bptree := structures.NewBPlusTree(2, compareInt)
+	btrees := []*structures.BTree{structures.NewBTree(2, compareInt), structures.NewBTree(3, compareInt)}
+	for _, num := range nums {
+		bst.Insert(num)
+		rbt.Insert(num)
+		bptree.Insert(num)
+		for _, btree := range btrees {
+			btree.Insert(num)
+		}
+	}
+
+	// 1 ordered structures
+	iterators := map[string][2]common.Iterator{
+		"bst": {bst.Iterator(), bst.ReverseIterator()},
+		"rbt": {rbt.Iterator(), rbt.ReverseIterator()},
+		"btree2": {btrees[0].Iterator(), btrees[0].ReverseIterator()},
+		"btree3": {btrees[1].Iterator(), btrees[1].ReverseIterator()},
+		"bptree": {bptree.Iterator(), bptree.ReverseIterator()},
+	}
+	for name, its := range iterators {
+		checkInts(t, "TestIterators1 " + name, ascending, take(its[0], -1))
+		checkInts(t, "TestIterators1 " + name + " reverse", descending, take(its[1], -1))
+		if _, ok := its[0].Next(); ok {
+			t.Errorf("TestIterators1 %s: the iterator should be exhausted", name)
+		}
+	}
+
+	// 2 only takes the first few values
+	checkInts(t, "TestIterators2 rbt", ascending[:3], take(rbt.Iterator(), 3))
+	checkInts(t, "TestIterators2 btree", descending[:3], take(btrees[0].ReverseIterator(), 3))
+
+	// 3 empty structures
+	empties := []common.Iterator{
+		structures.NewIntBSTree().Iterator(), structures.NewRedBlackTree(compareInt).ReverseIterator(),
+		structures.NewBTree(2, compareInt).Iterator(), structures.NewBTree(2, compareInt).ReverseIterator(),
+		structures.NewBPlusTree(2, compareInt).ReverseIterator(),
+		structures.NewLinkedList(compareInt).Iterator(), structures.NewDoubleLinkedList(compareInt).ReverseIterator(),
+	}
+	for i, it := range empties {
+		if _, ok := it.Next(); ok {
+			t.Errorf("TestIterators3: iterator %d should be empty", i)
+		}
+	}
+	for _, num := range nums {
+		rbt.Delete(num)
+	}
+	if _, ok := rbt.Iterator().Next(); ok {
+		t.Errorf("TestIterators3: iterator of an emptied tree should be empty")
+	}
+
+	// 4 linked lists
+	ll := structures.NewLinkedList(compareInt)
+	dll := structures.NewDoubleLinkedList(compareInt)
+	for i := len(nums) - 1; i >= 0; i -- {
+		ll.Insert(nums[i])
+		dll.Insert(nums[i])
+	}
+	checkInts(t, "TestIterators4 ll", nums, take(ll.Iterator(), -1))
+	checkInts(t, "TestIterators4 dll", nums, take(dll.Iterator(), -1))
+	reversed := make([]int, len(nums))
+	for i, num := range nums {
+		reversed[len(nums) - 1 - i] = num
+	}
+	checkInts(t, "TestIterators4 dll reverse", reversed, take(dll.ReverseIterator(), -1))
+}
@@ -0,0 +1,120 @@
+package tests
+
+import (
+	"some-data-structures/generics"
+	"testing"
+)
+
+func TestLinkedListGeneric(t *testing.T) {
+	nums := []int{1, 2, 3, 4, 5, 6}
+	l := len(nums)
+	compare := func(a, b int) int {
+		if a == b {
+			return 0
+		}
+		return 1
+	}
+
+	// 1
+	ll := generics.NewLinkedList(compare)
+	for i := 0; i < l; i ++ {
+		ll.Insert(nums[l - 1 - i])
+	}
+	s1 := ll.Search(5)
+	if s1 == nil || s1.Val != 5 {
+		t.Errorf("TestLinkedList1: wrong search")
+	}
+	for i := 0; i < l; i ++ {
+		s1 = ll.Delete(nums[i])
+		if s1 == nil || s1.Val != nums[i] {
+			t.Errorf("TestLinkedList1: wrong delete")
+		}
+	}
+}
+
+func TestLinkedListDeleteMiddle(t *testing.T) {
+	// 1 deletes the elements in the middle, and then the tail
+	ll := generics.NewLinkedList(compareIntT)
+	for i := 0; i < 6; i ++ {
+		ll.Append(i)
+	}
+	for _, v := range []int{3, 1, 4} {
+		if node := ll.Delete(v); node == nil || node.Val != v || node.Next != nil {
+			t.Errorf("TestLinkedListDeleteMiddle1: failed to delete %d", v)
+		}
+	}
+	if ll.Delete(3) != nil {
+		t.Errorf("TestLinkedListDeleteMiddle1: 3 has already been deleted")
+	}
+	checkInts(t, "TestLinkedListDeleteMiddle1", []int{0, 2, 5}, ll.ToSlice())
+	ll.Delete(5)
+	ll.Append(6)  // the tail must be updated after deleting the last node
+	checkInts(t, "TestLinkedListDeleteMiddle1", []int{0, 2, 6}, ll.ToSlice())
+
+	// 2 deletes all the duplicates
+	for _, v := range []int{2, 7, 2, 2} {
+		ll.Append(v)
+	}
+	if n := ll.DeleteAll(2); n != 4 || ll.Len() != 3 {
+		t.Errorf("TestLinkedListDeleteMiddle2: expected to delete 4 values, got %d", n)
+	}
+	checkInts(t, "TestLinkedListDeleteMiddle2", []int{0, 6, 7}, ll.ToSlice())
+	ll.Append(8)
+	checkInts(t, "TestLinkedListDeleteMiddle2", []int{0, 6, 7, 8}, ll.ToSlice())
+}
+
+func TestLinkedListOperations(t *testing.T) {
+	// 1 index operations
+	ll := generics.NewLinkedList(compareIntT)
+	for _, c := range []struct{ i, v int }{{0, 1}, {1, 3}, {1, 2}, {0, 0}, {4, 4}} {
+		if err := ll.InsertAt(c.i, c.v); err != nil {
+			t.Errorf("TestLinkedListOperations1: failed to insert %d at %d", c.v, c.i)
+		}
+	}
+	if ll.InsertAt(6, 0) == nil || ll.InsertAt(-1, 0) == nil {
+		t.Errorf("TestLinkedListOperations1: invalid indexes should be rejected")
+	}
+	checkInts(t, "TestLinkedListOperations1", []int{0, 1, 2, 3, 4}, ll.ToSlice())
+	if v, err := ll.Get(3); err != nil || v != 3 {
+		t.Errorf("TestLinkedListOperations1: expected 3 at 3, got %d", v)
+	}
+	if _, err := ll.Get(5); err == nil {
+		t.Errorf("TestLinkedListOperations1: index 5 should be invalid")
+	}
+	if v, err := ll.RemoveAt(4); err != nil || v != 4 {
+		t.Errorf("TestLinkedListOperations1: expected to remove 4, got %d", v)
+	}
+	if v, err := ll.RemoveAt(1); err != nil || v != 1 {
+		t.Errorf("TestLinkedListOperations1: expected to remove 1, got %d", v)
+	}
+	ll.Append(5)
+	checkInts(t, "TestLinkedListOperations1", []int{0, 2, 3, 5}, ll.ToSlice())
+
+	// 2 reverse
+	ll.Reverse()
+	ll.Append(-1)
+	checkInts(t, "TestLinkedListOperations2", []int{5, 3, 2, 0, -1}, ll.ToSlice())
+
+	// 3 split & concat
+	right, err := ll.Split(2)
+	if err != nil || ll.Len() != 2 || right.Len() != 3 {
+		t.Fatalf("TestLinkedListOperations3: failed to split")
+	}
+	checkInts(t, "TestLinkedListOperations3", []int{5, 3}, ll.ToSlice())
+	checkInts(t, "TestLinkedListOperations3", []int{2, 0, -1}, right.ToSlice())
+	if _, err := ll.Split(3); err == nil {
+		t.Errorf("TestLinkedListOperations3: index 3 should be invalid")
+	}
+	empty, _ := ll.Split(2)
+	if !empty.IsEmpty() || ll.Len() != 2 {
+		t.Errorf("TestLinkedListOperations3: splitting at the end should return an empty list")
+	}
+	right.Concat(ll)
+	if !ll.IsEmpty() || right.Len() != 5 {
+		t.Errorf("TestLinkedListOperations3: Concat should move all the nodes")
+	}
+	ll.Append(9)  // the moved list is still usable
+	right.Append(1)
+	checkInts(t, "TestLinkedListOperations3", []int{2, 0, -1, 5, 3, 1}, right.ToSlice())
+	checkInts(t, "TestLinkedListOperations3", []int{9}, ll.ToSlice())
+}
@@ -31,6 +31,19 @@ func TestLinkedList(t *testing.T) {
 		}
 	}
 
+	// 1.2 deletes the elements in the middle
+	for i := 0; i < l; i ++ {
+		ll.Insert(nums[l - 1 - i])
+	}
+	for _, v := range []int{4, 2, 6} {
+		if s1 = ll.Delete(v); s1 == nil || s1.Val.(int) != v {
+			t.Errorf("TestLinkedList1.2: failed to delete %d", v)
+		}
+	}
+	if ll.Len() != 3 || ll.Search(4) != nil || ll.Search(3) == nil {
+		t.Errorf("TestLinkedList1.2: wrong delete")
+	}
+
 	// 2
 	dll := structures.NewDoubleLinkedList(compare)
 	for i := 0; i < l; i ++ {
@@ -47,3 +60,77 @@ func TestLinkedList(t *testing.T) {
 		}
 	}
 }
+
+func dllInts(t *testing.T, name string, dll *structures.DoubleLinkedList, expected []int) {
+	got := make([]int, 0)
+	it := dll.Iterator()
+	for v, ok := it.Next(); ok; v, ok = it.Next() {
+		got = append(got, v.(int))
+	}
+	checkInts(t, name, expected, got)
+	reversed := make([]int, 0)
+	it = dll.ReverseIterator()
+	for v, ok := it.Next(); ok; v, ok = it.Next() {
+		reversed = append([]int{v.(int)}, reversed...)
+	}
+	checkInts(t, name + " reversed", expected, reversed)
+	if dll.Len() != len(expected) {
+		t.Errorf("%s: expected %d nodes, got %d", name, len(expected), dll.Len())
+	}
+}
+
+func TestDoubleLinkedListOperations(t *testing.T) {
+	// 1 push & pop at both ends
+	dll := structures.NewDoubleLinkedList(compareInt)
+	if dll.PopFront() != nil || dll.PopBack() != nil || dll.Front() != nil || dll.Back() != nil {
+		t.Errorf("TestDoubleLinkedListOperations1: the list should be empty")
+	}
+	n2 := dll.PushBack(2)
+	dll.PushBack(3)
+	n1 := dll.PushFront(1)
+	dllInts(t, "TestDoubleLinkedListOperations1", dll, []int{1, 2, 3})
+	if node := dll.PopBack(); node == nil || node.Val.(int) != 3 {
+		t.Errorf("TestDoubleLinkedListOperations1: expected to pop 3 from the back")
+	}
+	if node := dll.PopFront(); node != n1 {
+		t.Errorf("TestDoubleLinkedListOperations1: expected to pop 1 from the front")
+	}
+	dllInts(t, "TestDoubleLinkedListOperations1", dll, []int{2})
+
+	// 2 insert before & after, and move
+	n4 := dll.InsertAfter(4, n2)
+	dll.InsertBefore(0, n2)
+	dll.InsertBefore(3, n4)
+	dllInts(t, "TestDoubleLinkedListOperations2", dll, []int{0, 2, 3, 4})
+	dll.MoveToFront(n4)
+	dllInts(t, "TestDoubleLinkedListOperations2", dll, []int{4, 0, 2, 3})
+	dll.MoveToBack(n4)
+	dll.MoveToBack(n4)
+	dll.MoveToFront(n2)
+	dllInts(t, "TestDoubleLinkedListOperations2", dll, []int{2, 0, 3, 4})
+	if dll.Front() != n2 || dll.Back() != n4 {
+		t.Errorf("TestDoubleLinkedListOperations2: wrong front or back")
+	}
+	if dll.Delete(5) != nil || dll.Len() != 4 {
+		t.Errorf("TestDoubleLinkedListOperations2: 5 is not in the list")
+	}
+
+	// 3 nil values
+	nilCompare := func(a, b interface{}) int {
+		if a == b {
+			return 0
+		}
+		return 1
+	}
+	dll = structures.NewDoubleLinkedList(nilCompare)
+	dll.PushBack(1)
+	dll.PushBack(nil)
+	dll.PushBack(2)
+	if node := dll.Search(2); node == nil || node.Val.(int) != 2 {
+		t.Errorf("TestDoubleLinkedListOperations3: the search should pass the nil value")
+	}
+	if node := dll.Delete(nil); node == nil || node.Val != nil || dll.Len() != 2 {
+		t.Errorf("TestDoubleLinkedListOperations3: failed to delete the nil value")
+	}
+	dllInts(t, "TestDoubleLinkedListOperations3", dll, []int{1, 2})
+}
@@ -0,0 +1,206 @@
+package tests
+
+import (
+	"some-data-structures/generics"
+	"some-data-structures/structures"
+	"sync"
+	"testing"
+)
+
+// run with "go test -race ./tests" to detect data races
+func TestLockFreeQueue(t *testing.T) {
+	producers := 8
+	perProducer := 2000
+	q := generics.NewLockFreeQueue[int]()
+	if _, b := q.TryPop(); b || !q.IsEmpty() || q.Pop() != 0 {
+		t.Errorf("TestLockFreeQueue1: a new queue should be empty")
+	}
+
+	// 1 concurrent producers & consumers; every value is popped exactly once, and the values from the same producer
+	// are popped in order
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p ++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < perProducer; i ++ {
+				q.Push(i * producers + p)
+			}
+		}(p)
+	}
+	results := make([][]int, producers)
+	var consumed sync.WaitGroup
+	for c := 0; c < producers; c ++ {
+		consumed.Add(1)
+		go func(c int) {
+			defer consumed.Done()
+			for len(results[c]) < perProducer {
+				if v, b := q.TryPop(); b {
+					results[c] = append(results[c], v)
+				}
+			}
+		}(c)
+	}
+	wg.Wait()
+	consumed.Wait()
+
+	seen := make([]bool, producers * perProducer)
+	for c, values := range results {
+		last := make([]int, producers)  // the last value seen from every producer
+		for i := range last {
+			last[i] = -1
+		}
+		for _, v := range values {
+			if seen[v] {
+				t.Fatalf("TestLockFreeQueue1: %d is popped twice", v)
+			}
+			seen[v] = true
+			if p := v % producers; v <= last[p] {
+				t.Fatalf("TestLockFreeQueue1: consumer %d pops %d after %d", c, v, last[p])
+			} else {
+				last[p] = v
+			}
+		}
+	}
+	if !q.IsEmpty() || q.Len() != 0 {
+		t.Errorf("TestLockFreeQueue1: the queue should be empty, got %d values", q.Len())
+	}
+
+	// 2 FIFO in a single goroutine
+	sq := structures.NewLockFreeQueue()
+	for i := 0; i < 100; i ++ {
+		sq.Push(i)
+	}
+	if sq.Len() != 100 || !sq.HasNext() {
+		t.Errorf("TestLockFreeQueue2: expected 100 values, got %d", sq.Len())
+	}
+	for i := 0; i < 100; i ++ {
+		if v := sq.Pop(); v.(int) != i {
+			t.Errorf("TestLockFreeQueue2: expected %d, got %v", i, v)
+		}
+	}
+}
+
+// run with "go test -race ./tests" to detect data races
+func TestLockFreeStack(t *testing.T) {
+	workers := 8
+	perWorker := 2000
+	sk := generics.NewLockFreeStack[int]()
+	if _, b := sk.TryPop(); b || !sk.IsEmpty() || sk.Pop() != 0 {
+		t.Errorf("TestLockFreeStack1: a new stack should be empty")
+	}
+
+	// 1 every worker pushes & pops concurrently; every value is popped exactly once
+	var wg sync.WaitGroup
+	results := make([][]int, workers)
+	for w := 0; w < workers; w ++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWorker; i ++ {
+				sk.Push(i * workers + w)
+				if i % 2 == 1 {
+					for j := 0; j < 2; j ++ {
+						if v, b := sk.TryPop(); b {
+							results[w] = append(results[w], v)
+						}
+					}
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+	for v, b := sk.TryPop(); b; v, b = sk.TryPop() {
+		results[0] = append(results[0], v)
+	}
+
+	seen := make([]bool, workers * perWorker)
+	count := 0
+	for _, values := range results {
+		for _, v := range values {
+			if seen[v] {
+				t.Fatalf("TestLockFreeStack1: %d is popped twice", v)
+			}
+			seen[v] = true
+			count ++
+		}
+	}
+	if count != workers * perWorker || sk.Len() != 0 {
+		t.Errorf("TestLockFreeStack1: expected %d values popped, got %d", workers * perWorker, count)
+	}
+
+	// 2 LIFO in a single goroutine
+	ss := structures.NewLockFreeStack()
+	for i := 0; i < 100; i ++ {
+		ss.Push(i)
+	}
+	if ss.Len() != 100 || !ss.HasNext() {
+		t.Errorf("TestLockFreeStack2: expected 100 values, got %d", ss.Len())
+	}
+	for i := 99; i >= 0; i -- {
+		if v := ss.Pop(); v.(int) != i {
+			t.Errorf("TestLockFreeStack2: expected %d, got %v", i, v)
+		}
+	}
+}
+
+// the mutex-guarded Queue, which is how the structures were shared before the lock-free versions
+type mutexQueue struct {
+	mu sync.Mutex
+	q *generics.Queue[int]
+}
+
+func (mq *mutexQueue) Push(v int) {
+	mq.mu.Lock()
+	mq.q.Push(v)
+	mq.mu.Unlock()
+}
+
+func (mq *mutexQueue) Pop() int {
+	mq.mu.Lock()
+	defer mq.mu.Unlock()
+	return mq.q.Pop()
+}
+
+// every goroutine pushes a value and then pops one
+func benchmarkContention(b *testing.B, push func(v int), pop func() int) {
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			push(i)
+			pop()
+			i ++
+		}
+	})
+}
+
+func BenchmarkQueueContention(b *testing.B) {
+	b.Run("LockFreeQueue", func(b *testing.B) {
+		q := generics.NewLockFreeQueue[int]()
+		benchmarkContention(b, q.Push, q.Pop)
+	})
+	b.Run("MutexQueue", func(b *testing.B) {
+		q := &mutexQueue{q: generics.NewQueue[int]()}
+		benchmarkContention(b, q.Push, q.Pop)
+	})
+}
+
+func BenchmarkStackContention(b *testing.B) {
+	b.Run("LockFreeStack", func(b *testing.B) {
+		sk := generics.NewLockFreeStack[int]()
+		benchmarkContention(b, sk.Push, sk.Pop)
+	})
+	b.Run("MutexStack", func(b *testing.B) {
+		var mu sync.Mutex
+		sk := generics.NewStack[int]()
+		benchmarkContention(b, func(v int) {
+			mu.Lock()
+			sk.Push(v)
+			mu.Unlock()
+		}, func() int {
+			mu.Lock()
+			defer mu.Unlock()
+			return sk.Pop()
+		})
+	})
+}
@@ -0,0 +1,159 @@
+package tests
+
+import (
+	"math/rand"
+	"some-data-structures/common"
+	"some-data-structures/generics"
+	"some-data-structures/structures"
+	"sort"
+	"testing"
+)
+
+var (
+	_ common.MergeableHeap[int, *generics.FibNode[int], *generics.FibonacciHeap[int]] = generics.NewFibonacciHeap(compareIntT)
+	_ common.MergeableHeap[int, *generics.PairingNode[int], *generics.PairingHeap[int]] = generics.NewPairingHeap(compareIntT)
+	_ common.MergeableHeap[int, *generics.BinomialNode[int], *generics.BinomialHeap[int]] = generics.NewBinomialHeap(compareIntT)
+)
+
+// runs random operations on the heaps created by newHeap and compares them with a sorted-slice model; val returns
+// the value of a node
+func checkMergeableHeap[N comparable, H common.MergeableHeap[int, N, H]](t *testing.T, name string,
+	newHeap func() H, val func(N) int) {
+	var nilNode N
+	heap := newHeap()
+	if heap.Minimum() != nilNode || heap.ExtractMin() != nilNode || !heap.IsEmpty() {
+		t.Errorf("%s: an empty heap should have no minimum", name)
+	}
+
+	nodes := make([]N, 0)
+	removeNode := func(node N) {
+		for i := range nodes {
+			if nodes[i] == node {
+				nodes = append(nodes[:i], nodes[i + 1:]...)
+				return
+			}
+		}
+		t.Fatalf("%s: node %d is not in the model", name, val(node))
+	}
+	sortedModel := func() []int {
+		r := make([]int, len(nodes))
+		for i, node := range nodes {
+			r[i] = val(node)
+		}
+		sort.Ints(r)
+		return r
+	}
+
+	r := rand.New(rand.NewSource(18))
+	for i := 0; i < 3000; i ++ {
+		switch op := r.Intn(6); {
+		case op <= 1 || len(nodes) == 0:
+			nodes = append(nodes, heap.Insert(r.Intn(1000)))
+		case op == 2:
+			expected := sortedModel()[0]
+			if m := heap.Minimum(); val(m) != expected {
+				t.Fatalf("%s op %d: expected the minimum %d, got %d", name, i, expected, val(m))
+			}
+			node := heap.ExtractMin()
+			if val(node) != expected {
+				t.Fatalf("%s op %d: expected to extract %d, got %d", name, i, expected, val(node))
+			}
+			removeNode(node)
+		case op == 3:
+			node := nodes[r.Intn(len(nodes))]
+			if err := heap.DecreaseKey(node, val(node) - r.Intn(100)); err != nil {
+				t.Fatalf("%s op %d: %v", name, i, err)
+			}
+			if heap.DecreaseKey(node, val(node) + 1) == nil {
+				t.Fatalf("%s op %d: a greater key should be rejected", name, i)
+			}
+		case op == 4:
+			node := nodes[r.Intn(len(nodes))]
+			if err := heap.Delete(node); err != nil {
+				t.Fatalf("%s op %d: %v", name, i, err)
+			}
+			removeNode(node)
+		default:  // unites with another heap; the handles move to the new heap
+			other := newHeap()
+			for j := r.Intn(5); j > 0; j -- {
+				nodes = append(nodes, other.Insert(r.Intn(1000)))
+			}
+			united := heap.Union(other)
+			if !heap.IsEmpty() || !other.IsEmpty() {
+				t.Fatalf("%s op %d: the old heaps should be empty after Union", name, i)
+			}
+			heap = united
+		}
+		if heap.Len() != len(nodes) {
+			t.Fatalf("%s op %d: expected %d nodes, got %d", name, i, len(nodes), heap.Len())
+		}
+	}
+
+	values := heap.Values()
+	sort.Ints(values)
+	checkInts(t, name, sortedModel(), values)
+	for _, expected := range sortedModel() {
+		if node := heap.ExtractMin(); val(node) != expected {
+			t.Fatalf("%s: expected to extract %d, got %d", name, expected, val(node))
+		}
+	}
+	if !heap.IsEmpty() {
+		t.Errorf("%s: the heap should be empty", name)
+	}
+}
+
+func TestMergeableHeaps(t *testing.T) {
+	checkMergeableHeap(t, "TestMergeableHeaps FibonacciHeap", func() *generics.FibonacciHeap[int] {
+		return generics.NewFibonacciHeap(compareIntT)
+	}, func(node *generics.FibNode[int]) int { return node.Val })
+	checkMergeableHeap(t, "TestMergeableHeaps PairingHeap", func() *generics.PairingHeap[int] {
+		return generics.NewPairingHeap(compareIntT)
+	}, func(node *generics.PairingNode[int]) int { return node.Val })
+	checkMergeableHeap(t, "TestMergeableHeaps BinomialHeap", func() *generics.BinomialHeap[int] {
+		return generics.NewBinomialHeap(compareIntT)
+	}, func(node *generics.BinomialNode[int]) int { return node.Val })
+}
+
+func TestPairingAndBinomialHeap(t *testing.T) {
+	// the interface{} versions
+	ph := structures.NewPairingHeap(compareInt)
+	bh := structures.NewBinomialHeap(compareInt)
+	phNodes := make([]*structures.PairingNode, 0)
+	bhNodes := make([]*structures.BinomialNode, 0)
+	for _, num := range []int{7, 3, 9, 1, 5} {
+		phNodes = append(phNodes, ph.Insert(num))
+		bhNodes = append(bhNodes, bh.Insert(num))
+	}
+	if ph.Minimum().Val.(int) != 1 || bh.Minimum().Val.(int) != 1 {
+		t.Errorf("TestPairingAndBinomialHeap1: the minimum should be 1")
+	}
+	// decreases 9 to 0, and deletes 3
+	if ph.DecreaseKey(phNodes[2], 0) != nil || bh.DecreaseKey(bhNodes[2], 0) != nil {
+		t.Errorf("TestPairingAndBinomialHeap2: failed to decrease the key")
+	}
+	if ph.Delete(phNodes[1]) != nil || bh.Delete(bhNodes[1]) != nil {
+		t.Errorf("TestPairingAndBinomialHeap2: failed to delete")
+	}
+	if ph.Delete(phNodes[1]) == nil || bh.Delete(bhNodes[1]) == nil {
+		t.Errorf("TestPairingAndBinomialHeap2: a deleted node should not be deleted again")
+	}
+	if ph.DecreaseKey(phNodes[1], 0) == nil || bh.DecreaseKey(bhNodes[1], 0) == nil {
+		t.Errorf("TestPairingAndBinomialHeap2: the key of a deleted node should not be decreased")
+	}
+	if ph.Delete(nil) == nil || bh.Delete(nil) == nil || ph.DecreaseKey(nil, 0) == nil || bh.DecreaseKey(nil, 0) == nil {
+		t.Errorf("TestPairingAndBinomialHeap2: a nil node should be rejected")
+	}
+	for _, expected := range []int{0, 1, 5, 7} {
+		phMin, bhMin := ph.ExtractMin(), bh.ExtractMin()
+		if phMin.Val.(int) != expected || bhMin.Val.(int) != expected {
+			t.Errorf("TestPairingAndBinomialHeap3: expected to extract %d", expected)
+		}
+		if ph.Delete(phMin) == nil || bh.Delete(bhMin) == nil || ph.DecreaseKey(phMin, -1) == nil ||
+			bh.DecreaseKey(bhMin, -1) == nil {
+			t.Errorf("TestPairingAndBinomialHeap3: an extracted node should be rejected")
+		}
+	}
+	if ph.NumOfElements() != 0 || bh.NumOfElements() != 0 {
+		t.Errorf("TestPairingAndBinomialHeap3: the heaps should be empty")
+	}
+}
@@ -0,0 +1,79 @@
+package tests
+
+import (
+	"math/rand"
+	"some-data-structures/generics"
+	"some-data-structures/structures"
+	"sort"
+	"testing"
+)
+
+func TestMinMaxHeap(t *testing.T) {
+	// 1 heapify
+	heap, err := structures.NewMinMaxHeapWithValues([]int{16, 4, 10, 14, 7, 9, 3, 2, 8, 1}, compareInt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := heap.PeekMin(); v.(int) != 1 {
+		t.Errorf("TestMinMaxHeap1: expected min 1, got %v", v)
+	}
+	if v, _ := heap.PeekMax(); v.(int) != 16 {
+		t.Errorf("TestMinMaxHeap1: expected max 16, got %v", v)
+	}
+	got := make([]int, 0)
+	for heap.Size() > 0 {
+		v, _ := heap.PopMax()
+		got = append(got, v.(int))
+	}
+	checkInts(t, "TestMinMaxHeap1", []int{16, 14, 10, 9, 8, 7, 4, 3, 2, 1}, got)
+	if _, err := heap.PopMin(); err == nil {
+		t.Errorf("TestMinMaxHeap1: the heap should be empty")
+	}
+	if _, err := heap.PeekMax(); err == nil {
+		t.Errorf("TestMinMaxHeap1: the heap should be empty")
+	}
+	if _, err := structures.NewMinMaxHeapWithValues(1, compareInt); err == nil {
+		t.Errorf("TestMinMaxHeap1: 1 is not a slice")
+	}
+
+	// 2 random operations compared with a sorted slice
+	r := rand.New(rand.NewSource(14))
+	for _, n := range []int{0, 1, 2, 3, 10, 100} {
+		values := make([]int, n)
+		for i := range values {
+			values[i] = r.Intn(50)
+		}
+		model := append([]int{}, values...)
+		sort.Ints(model)
+		mmh := generics.NewMinMaxHeapWithValues(values, compareIntT)
+		for i := 0; i < 1000; i ++ {
+			switch op := r.Intn(3); {
+			case op == 0 || len(model) == 0:
+				v := r.Intn(50)
+				mmh.Insert(v)
+				model = append(model, v)
+				sort.Ints(model)
+			case r.Intn(2) == 0:
+				if v, err := mmh.PopMin(); err != nil || v != model[0] {
+					t.Fatalf("TestMinMaxHeap2: expected min %d, got %d", model[0], v)
+				}
+				model = model[1:]
+			default:
+				if v, err := mmh.PopMax(); err != nil || v != model[len(model) - 1] {
+					t.Fatalf("TestMinMaxHeap2: expected max %d, got %d", model[len(model) - 1], v)
+				}
+				model = model[:len(model) - 1]
+			}
+			if mmh.Size() != len(model) {
+				t.Fatalf("TestMinMaxHeap2: expected size %d, got %d", len(model), mmh.Size())
+			}
+			if len(model) > 0 {
+				min, _ := mmh.PeekMin()
+				max, _ := mmh.PeekMax()
+				if min != model[0] || max != model[len(model) - 1] {
+					t.Fatalf("TestMinMaxHeap2: expected [%d, %d], got [%d, %d]", model[0], model[len(model) - 1], min, max)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,266 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"some-data-structures/generics"
+	"some-data-structures/structures"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func checkPagedBTree(t *testing.T, name string, tree *structures.PagedBTree, expected []int) {
+	if tree.NumOfElements() != len(expected) {
+		t.Errorf("%s: expected %d elements, got %d", name, len(expected), tree.NumOfElements())
+	}
+	values, err := tree.Values()
+	if err != nil {
+		t.Fatalf("%s: %v", name, err)
+	}
+	got := make([]int, len(values))
+	for i, v := range values {
+		got[i] = v.(int)
+	}
+	checkInts(t, name, expected, got)
+}
+
+func TestPagedBTree(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "btree.db")
+	r := rand.New(rand.NewSource(11))
+
+	// 1 insert with a cache much smaller than the tree
+	tree, err := structures.OpenPagedBTree(path, 3, 128, 4, compareInt, structures.IntCodec)
+	if err != nil {
+		t.Fatalf("TestPagedBTree1: %v", err)
+	}
+	model := make([]int, 0)
+	for i := 0; i < 2000; i ++ {
+		num := r.Intn(500)
+		if err := tree.Insert(num); err != nil {
+			t.Fatalf("TestPagedBTree1: %v", err)
+		}
+		model = append(model, num)
+	}
+	sort.Ints(model)
+	checkPagedBTree(t, "TestPagedBTree1", tree, model)
+	for _, num := range []int{model[0], model[1000], model[len(model) - 1]} {
+		if v, b, err := tree.Search(num); err != nil || !b || v.(int) != num {
+			t.Errorf("TestPagedBTree1: fail to search %d", num)
+		}
+	}
+	if _, b, _ := tree.Search(-1); b {
+		t.Errorf("TestPagedBTree1: -1 should not exist")
+	}
+
+	// 2 delete half of the values
+	for i := 0; i < 1000; i ++ {
+		index := r.Intn(len(model))
+		if b, err := tree.Delete(model[index]); err != nil || !b {
+			t.Fatalf("TestPagedBTree2: fail to delete %d, %v", model[index], err)
+		}
+		model = append(model[:index], model[index + 1:]...)
+	}
+	if b, err := tree.Delete(1000); err != nil || b {
+		t.Errorf("TestPagedBTree2: 1000 should not exist")
+	}
+	checkPagedBTree(t, "TestPagedBTree2", tree, model)
+	if err := tree.Close(); err != nil {
+		t.Errorf("TestPagedBTree2: %v", err)
+	}
+	if err := tree.Insert(1); err == nil {
+		t.Errorf("TestPagedBTree2: a closed tree should not be used")
+	}
+
+	// 3 reopen the file; t & page size are read from the file, and the freed pages are reused
+	info, _ := os.Stat(path)
+	tree, err = structures.OpenPagedBTree(path, 10, 4096, 2, compareInt, structures.IntCodec)
+	if err != nil {
+		t.Fatalf("TestPagedBTree3: %v", err)
+	}
+	if tree.T() != 3 {
+		t.Errorf("TestPagedBTree3: expected t = 3, got %d", tree.T())
+	}
+	checkPagedBTree(t, "TestPagedBTree3", tree, model)
+	for i := 0; i < 500; i ++ {
+		_ = tree.Insert(i)
+		model = append(model, i)
+	}
+	sort.Ints(model)
+	checkPagedBTree(t, "TestPagedBTree3", tree, model)
+	if newInfo, _ := os.Stat(path); newInfo.Size() > info.Size() {
+		t.Errorf("TestPagedBTree3: the file grows from %d to %d bytes instead of reusing the freed pages", info.Size(), newInfo.Size())
+	}
+	for len(model) > 0 {
+		if b, err := tree.Delete(model[len(model) - 1]); err != nil || !b {
+			t.Fatalf("TestPagedBTree3: fail to delete %d, %v", model[len(model) - 1], err)
+		}
+		model = model[:len(model) - 1]
+	}
+	checkPagedBTree(t, "TestPagedBTree3", tree, model)
+	_ = tree.Close()
+
+	// 4 errors
+	if _, err := structures.OpenPagedBTree(filepath.Join(t.TempDir(), "x"), 1, 128, 4, compareInt, structures.IntCodec); err == nil {
+		t.Errorf("TestPagedBTree4: t = 1 should be rejected")
+	}
+	if _, err := structures.OpenPagedBTree(filepath.Join(t.TempDir(), "x"), 3, 16, 4, compareInt, structures.IntCodec); err == nil {
+		t.Errorf("TestPagedBTree4: the page size 16 should be rejected")
+	}
+	junk := filepath.Join(t.TempDir(), "junk")
+	_ = os.WriteFile(junk, []byte(strings.Repeat("junk", 100)), 0644)
+	if _, err := structures.OpenPagedBTree(junk, 3, 128, 4, compareInt, structures.IntCodec); err != generics.ErrCorruptedData {
+		t.Errorf("TestPagedBTree4: expected ErrCorruptedData, got %v", err)
+	}
+	strTree, err := generics.OpenPagedBTree[string](filepath.Join(t.TempDir(), "str"), 2, 64, 4, func(a, b string) int {
+		return strings.Compare(a, b)
+	}, generics.StringCodec{})
+	if err != nil {
+		t.Fatalf("TestPagedBTree4: %v", err)
+	}
+	if err := strTree.Insert(strings.Repeat("a", 100)); err != generics.ErrPageOverflow {
+		t.Errorf("TestPagedBTree4: expected ErrPageOverflow, got %v", err)
+	}
+	_ = strTree.Close()
+}
+
+func TestPagedBTreeOverflow(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "str.db")
+	compare := func(a, b string) int {
+		return strings.Compare(a, b)
+	}
+	tree, err := generics.OpenPagedBTree[string](path, 2, 64, 2, compare, generics.StringCodec{})
+	if err != nil {
+		t.Fatalf("TestPagedBTreeOverflow: %v", err)
+	}
+	expected := make([]string, 0)
+	insert := func(s string) {
+		if err := tree.Insert(s); err != nil {
+			t.Fatalf("TestPagedBTreeOverflow: fail to insert %s, %v", s, err)
+		}
+		expected = append(expected, s)
+	}
+	for _, s := range []string{"f", "b", "d", "a", "e", "c"} {
+		insert(s)
+	}
+
+	// the oversized key is rejected, and the tree is left unchanged & keeps working
+	big := strings.Repeat("x", 100)
+	if err := tree.Insert(big); err != generics.ErrPageOverflow {
+		t.Errorf("TestPagedBTreeOverflow: expected ErrPageOverflow, got %v", err)
+	}
+	if tree.NumOfElements() != len(expected) {
+		t.Errorf("TestPagedBTreeOverflow: expected %d elements, got %d", len(expected), tree.NumOfElements())
+	}
+	if _, b, err := tree.Search(big); err != nil || b {
+		t.Errorf("TestPagedBTreeOverflow: the oversized key should not exist, %v", err)
+	}
+	if v, b, err := tree.Search("c"); err != nil || !b || v != "c" {
+		t.Errorf("TestPagedBTreeOverflow: fail to search c, %v", err)
+	}
+	for _, s := range []string{"h", "g", "i"} {
+		insert(s)
+	}
+	if b, err := tree.Delete("a"); err != nil || !b {
+		t.Errorf("TestPagedBTreeOverflow: fail to delete a, %v", err)
+	}
+	sort.Strings(expected)
+	expected = expected[1:]
+	if err := tree.Close(); err != nil {
+		t.Errorf("TestPagedBTreeOverflow: %v", err)
+	}
+
+	// reopen the file; a junk journal, e.g., an incomplete one left by a crash, is ignored
+	_ = os.WriteFile(path + "-journal", []byte(strings.Repeat("junk", 100)), 0644)
+	tree, err = generics.OpenPagedBTree[string](path, 2, 64, 2, compare, generics.StringCodec{})
+	if err != nil {
+		t.Fatalf("TestPagedBTreeOverflow: %v", err)
+	}
+	values, err := tree.Values()
+	if err != nil {
+		t.Fatalf("TestPagedBTreeOverflow: %v", err)
+	}
+	if strings.Join(values, ",") != strings.Join(expected, ",") {
+		t.Errorf("TestPagedBTreeOverflow: expected %v, got %v", expected, values)
+	}
+	if tree.NumOfElements() != len(expected) {
+		t.Errorf("TestPagedBTreeOverflow: expected %d elements, got %d", len(expected), tree.NumOfElements())
+	}
+	_ = tree.Close()
+}
+
+func TestPagedBTreeJournal(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "btree.db")
+	const pageSize = 128
+	open := func() *structures.PagedBTree {
+		tree, err := structures.OpenPagedBTree(path, 2, pageSize, 4, compareInt, structures.IntCodec)
+		if err != nil {
+			t.Fatalf("TestPagedBTreeJournal: %v", err)
+		}
+		return tree
+	}
+
+	// 1 the committed state
+	tree := open()
+	model := make([]int, 0)
+	for i := 0; i < 200; i ++ {
+		_ = tree.Insert(i)
+		model = append(model, i)
+	}
+	_ = tree.Close()
+	committed, _ := os.ReadFile(path)
+
+	// 2 the next modification, which is interrupted after the journal is written
+	tree = open()
+	for i := 200; i < 400; i ++ {
+		_ = tree.Insert(i)
+	}
+	for i := 0; i < 100; i += 2 {
+		_, _ = tree.Delete(i)
+	}
+	_ = tree.Close()
+	modified, _ := os.ReadFile(path)
+
+	// the journal saves the header & the committed content of the overwritten pages; see writeJournal
+	journal := make([]byte, 24)
+	copy(journal[4:], "PBJ1")
+	binary.BigEndian.PutUint32(journal[8:], pageSize)
+	binary.BigEndian.PutUint64(journal[16:], uint64(len(committed)))
+	count := 0
+	for offset := 0; offset < len(committed); offset += pageSize {
+		if offset == 0 || !bytes.Equal(committed[offset:offset + pageSize], modified[offset:offset + pageSize]) {
+			id := make([]byte, 4)
+			binary.BigEndian.PutUint32(id, uint32(offset / pageSize))
+			journal = append(append(journal, id...), committed[offset:offset + pageSize]...)
+			count ++
+		}
+	}
+	binary.BigEndian.PutUint32(journal[12:], uint32(count))
+	binary.BigEndian.PutUint32(journal, crc32.ChecksumIEEE(journal[4:]))
+	if count < 2 {
+		t.Fatalf("TestPagedBTreeJournal: the modification should overwrite some pages")
+	}
+
+	// the crash happens while writing the pages: the second half of the file is torn
+	torn := append([]byte{}, modified...)
+	for i := len(torn) / pageSize / 2 * pageSize; i < len(torn); i ++ {
+		torn[i] = 0xff
+	}
+	_ = os.WriteFile(path, torn, 0644)
+	_ = os.WriteFile(path + "-journal", journal, 0644)
+
+	// 3 replaying the journal restores the committed pages
+	tree = open()
+	checkPagedBTree(t, "TestPagedBTreeJournal3", tree, model)
+	if info, err := os.Stat(path + "-journal"); err != nil || info.Size() != 0 {
+		t.Errorf("TestPagedBTreeJournal3: the journal should be emptied after replaying")
+	}
+	_ = tree.Close()
+	if restored, _ := os.ReadFile(path); !bytes.Equal(restored, committed) {
+		t.Errorf("TestPagedBTreeJournal3: the file is not restored to the committed state")
+	}
+}
@@ -0,0 +1,98 @@
+package tests
+
+import (
+	"math/rand"
+	"some-data-structures/structures"
+	"sort"
+	"testing"
+)
+
+func checkPersistentVersion(t *testing.T, name string, tree *structures.PersistentRedBlackTree, expected []int) {
+	if tree.Size() != len(expected) {
+		t.Errorf("%s: expected size %d, got %d", name, len(expected), tree.Size())
+	}
+	values := tree.InOrderTreeWalk()
+	got := make([]int, len(values))
+	for i, v := range values {
+		got[i] = v.(int)
+	}
+	checkInts(t, name, expected, got)
+}
+
+func TestPersistentRedBlackTree(t *testing.T) {
+	nums := []int{16, 3, 7, 11, 9, 26, 18, 14, 15, 1}
+
+	// 1 every insertion creates a new version; the old versions are unchanged
+	versions := []*structures.PersistentRedBlackTree{structures.NewPersistentRedBlackTree(compareInt)}
+	for _, num := range nums {
+		tree, b := versions[len(versions) - 1].Insert(num)
+		if !b {
+			t.Errorf("TestPersistentRedBlackTree1: fail to insert %d", num)
+		}
+		versions = append(versions, tree)
+	}
+	for i, tree := range versions {
+		expected := append([]int{}, nums[:i]...)
+		sort.Ints(expected)
+		checkPersistentVersion(t, "TestPersistentRedBlackTree1", tree, expected)
+	}
+	last := versions[len(versions) - 1]
+	if tree, b := last.Insert(7); b || tree != last {
+		t.Errorf("TestPersistentRedBlackTree1: 7 should not be inserted twice")
+	}
+	if v, b := last.Search(11); !b || v.(int) != 11 {
+		t.Errorf("TestPersistentRedBlackTree1: fail to find 11")
+	}
+	if _, b := versions[3].Search(11); b {
+		t.Errorf("TestPersistentRedBlackTree1: 11 should not exist in an old version")
+	}
+
+	// 2 deletions
+	tree, b := last.Delete(11)
+	if !b {
+		t.Errorf("TestPersistentRedBlackTree2: fail to delete 11")
+	}
+	if _, b := tree.Search(11); b {
+		t.Errorf("TestPersistentRedBlackTree2: 11 should have been deleted")
+	}
+	if _, b := last.Search(11); !b {
+		t.Errorf("TestPersistentRedBlackTree2: 11 should still exist in the old version")
+	}
+	if tmp, b := tree.Delete(11); b || tmp != tree {
+		t.Errorf("TestPersistentRedBlackTree2: 11 should not be deleted twice")
+	}
+	checkPersistentVersion(t, "TestPersistentRedBlackTree2", tree, []int{1, 3, 7, 9, 14, 15, 16, 18, 26})
+	checkPersistentVersion(t, "TestPersistentRedBlackTree2", last, []int{1, 3, 7, 9, 11, 14, 15, 16, 18, 26})
+
+	// 3 random operations with duplicates; every version is compared with a sorted slice
+	r := rand.New(rand.NewSource(8))
+	tree = structures.NewPersistentRedBlackTree(compareInt)
+	versions = []*structures.PersistentRedBlackTree{tree}
+	models := [][]int{{}}
+	for i := 0; i < 2000; i ++ {
+		model := append([]int{}, models[len(models) - 1]...)
+		num := r.Intn(50)
+		if r.Intn(2) == 0 {
+			tree = tree.UnsafeInsert(num)
+			model = append(model, num)
+			sort.Ints(model)
+		} else {
+			index := sort.SearchInts(model, num)
+			exists := index < len(model) && model[index] == num
+			if tree, b = tree.Delete(num); b != exists {
+				t.Errorf("TestPersistentRedBlackTree3: expected %v when deleting %d, got %v", exists, num, b)
+			}
+			if exists {
+				model = append(model[:index], model[index + 1:]...)
+			}
+		}
+		versions = append(versions, tree)
+		models = append(models, model)
+	}
+	for i := range versions {
+		checkPersistentVersion(t, "TestPersistentRedBlackTree3", versions[i], models[i])
+		if n := versions[i].Size(); n > 0 && (1 << (versions[i].Height() / 2)) > n + 1 {
+			t.Errorf("TestPersistentRedBlackTree3: the tree with %d nodes is too high", n)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package tests
+
+import (
+	"math/rand"
+	"some-data-structures/generics"
+	"strconv"
+	"testing"
+)
+
+// sortedSliceQ is the former implementation of PriorityQ, which keeps a sorted slice; it is kept for the benchmarks.
+type sortedSliceQ[T any] struct {
+	queue []T
+	compare func(a, b T) int
+}
+
+func (pq *sortedSliceQ[T]) swap(i, j int) {
+	pq.queue[i], pq.queue[j] = pq.queue[j], pq.queue[i]
+}
+
+func (pq *sortedSliceQ[T]) Push(v T) {
+	pq.queue = append(pq.queue, v)
+
+	if len(pq.queue) == 1 {
+		return
+	}
+
+	last := len(pq.queue) - 1
+
+	if pq.compare(v, pq.queue[0]) != 1 {
+		for i := 0; i < last; i ++ {
+			pq.swap(i, last)
+		}
+		return
+	}
+	if pq.compare(pq.queue[last - 1], v) != 1 {
+		return
+	}
+
+	right := last - 1
+	left := 0
+	mid := 0
+
+	for {
+		mid = (left + right) / 2
+		if left >= right {
+			break
+		}
+		c1 := pq.compare(v, pq.queue[mid])
+		c2 := pq.compare(v, pq.queue[mid + 1])
+		if c1 == 1 && c2 != 1 {
+			break
+		} else if c1 != 1 {
+			right = mid - 1
+		} else {
+			left = mid + 1
+		}
+	}
+	for i := mid + 1; i < last; i ++ {
+		pq.swap(i, last)
+	}
+}
+
+func (pq *sortedSliceQ[T]) Pop() T {
+	v := pq.queue[0]
+	pq.queue = pq.queue[1:]
+	return v
+}
+
+func benchmarkPriorityQ(b *testing.B, n int, push func(v int), pop func() int) {
+	r := rand.New(rand.NewSource(15))
+	values := make([]int, n)
+	for i := range values {
+		values[i] = r.Intn(n)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i ++ {
+		for _, v := range values {
+			push(v)
+		}
+		for range values {
+			pop()
+		}
+	}
+}
+
+// go test -bench PriorityQ ./tests
+func BenchmarkPriorityQ(b *testing.B) {
+	for _, n := range []int{100, 1000, 10000} {
+		b.Run("heap-" + strconv.Itoa(n), func(b *testing.B) {
+			pq := generics.NewPriorityQ(compareIntT)
+			benchmarkPriorityQ(b, n, func(v int) { pq.Push(v) }, pq.Pop)
+		})
+		b.Run("sorted-slice-" + strconv.Itoa(n), func(b *testing.B) {
+			pq := &sortedSliceQ[int]{queue: make([]int, 0), compare: compareIntT}
+			benchmarkPriorityQ(b, n, pq.Push, pq.Pop)
+		})
+	}
+}
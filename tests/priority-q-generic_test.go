@@ -0,0 +1,33 @@
+package tests
+
+import (
+	"some-data-structures/generics"
+	"sort"
+	"testing"
+)
+
+func TestPriorityQGeneric(t *testing.T) {
+	nums := []int{9, 8, 7, 1, 14, 12, -8, 10, 6, 5}
+	compare := func(a, b int) int {
+		if a > b {
+			return 1
+		}
+		return 0
+	}
+	pq := generics.NewPriorityQ(compare)
+	for _, num := range nums {
+		pq.Push(num)
+	}
+	if l := pq.Len(); l != len(nums) {
+		t.Errorf("expected priority length %d, got %d", len(nums), l)
+	}
+	sort.Ints(nums)
+	for i := 0; i < len(nums); i ++ {
+		if tmp := pq.Pop(); nums[i] != tmp {
+			t.Errorf("expected %d th element %d, but got %d", i, nums[i], tmp)
+		}
+	}
+	if l := pq.Len(); l != 0 {
+		t.Errorf("expected priority length 0, got %d", l)
+	}
+}
@@ -1,8 +1,10 @@
 package tests
 
 import (
+	"math/rand"
 	"some-data-structures/structures"
 	"sort"
+	"strconv"
 	"testing"
 )
 
@@ -31,3 +33,78 @@ func TestPriorityQ(t *testing.T) {
 		t.Errorf("expected priority length 0, got %d", l)
 	}
 }
+
+func TestPriorityQOperations(t *testing.T) {
+	type task struct {
+		priority int
+		name string
+	}
+	compare := func(a, b interface{}) int {
+		return compareInt(a.(task).priority, b.(task).priority)
+	}
+
+	// 1 equal priorities are popped in the order they are pushed
+	pq := structures.NewPriorityQ(compare)
+	pq.PushAll(task{2, "a"}, task{1, "b"}, task{2, "c"}, task{1, "d"}, task{2, "e"})
+	pq.Push(task{1, "f"})
+	if v := pq.Peek().(task); v.name != "b" || pq.Len() != 6 {
+		t.Errorf("TestPriorityQOperations1: expected to peek b, got %s", v.name)
+	}
+	order := ""
+	for pq.HasNext() {
+		order += pq.Pop().(task).name
+	}
+	if order != "bdface" {
+		t.Errorf("TestPriorityQOperations1: expected bdface, got %s", order)
+	}
+
+	// 2 update
+	items := make([]*structures.PQItem, 0)
+	for i, name := range []string{"a", "b", "c", "d", "e"} {
+		items = append(items, pq.Push(task{i, name}))
+	}
+	if !pq.Update(items[4], task{-1, "e"}) || !pq.Update(items[0], task{10, "a"}) {
+		t.Errorf("TestPriorityQOperations2: fail to update")
+	}
+	cp := pq.Copy()
+	if pq.Pop().(task).name != "e" {
+		t.Errorf("TestPriorityQOperations2: e should be the first")
+	}
+	if pq.Update(items[4], task{0, "e"}) {
+		t.Errorf("TestPriorityQOperations2: a popped item should not be updated")
+	}
+	order = ""
+	for pq.HasNext() {
+		order += pq.Pop().(task).name
+	}
+	if order != "bcda" {
+		t.Errorf("TestPriorityQOperations2: expected bcda, got %s", order)
+	}
+	if cp.Len() != 5 || cp.Update(items[1], task{0, "b"}) {
+		t.Errorf("TestPriorityQOperations2: the copy should not be affected by the original handles")
+	}
+
+	// 3 random pushes & pops compared with a stable sort
+	r := rand.New(rand.NewSource(15))
+	pq.Reset()
+	model := make([]task, 0)
+	seq := 0
+	for i := 0; i < 2000; i ++ {
+		if r.Intn(3) != 0 || len(model) == 0 {
+			v := task{r.Intn(20), strconv.Itoa(seq)}
+			seq ++
+			if r.Intn(10) == 0 {
+				pq.PushAll(v)
+			} else {
+				pq.Push(v)
+			}
+			model = append(model, v)
+			sort.SliceStable(model, func(i, j int) bool { return model[i].priority < model[j].priority })
+		} else {
+			if v := pq.Pop().(task); v != model[0] {
+				t.Fatalf("TestPriorityQOperations3: expected %v, got %v", model[0], v)
+			}
+			model = model[1:]
+		}
+	}
+}
@@ -2,6 +2,7 @@ package tests
 
 import (
 	"some-data-structures/structures"
+	"sort"
 	"testing"
 )
 
@@ -95,3 +96,78 @@ func TestRedBlackTree(t *testing.T) {
 		t.Errorf("RBT3.4; expected height 3, got %d", h)
 	}
 }
+
+func TestRedBlackTreeOrderStatistics(t *testing.T) {
+	tree := structures.NewRedBlackTree(compareInt)
+	if tree.Size() != 0 {
+		t.Errorf("RBTOS1: expected size 0, got %d", tree.Size())
+	}
+	if _, b := tree.Select(0); b {
+		t.Errorf("RBTOS1: select on an empty tree should fail")
+	}
+
+	// 2 insert duplicated values and compare with a sorted slice
+	sorted := make([]int, 0)
+	for i := 0; i < 300; i ++ {
+		v := (i * 7) % 100
+		tree.UnsafeInsert(v)
+		sorted = append(sorted, v)
+	}
+	sort.Ints(sorted)
+	checkOrderStatistics(t, "RBTOS2", tree, sorted)
+
+	// 3 delete from both ends and the middle
+	for i := 0; i < 250; i ++ {
+		v := (i * 13) % 100
+		idx := sort.SearchInts(sorted, v)
+		if idx < len(sorted) && sorted[idx] == v {
+			sorted = append(sorted[:idx], sorted[idx + 1:]...)
+			if !tree.Delete(v) {
+				t.Errorf("RBTOS3: fail to delete %d", v)
+			}
+		}
+	}
+	checkOrderStatistics(t, "RBTOS3", tree, sorted)
+}
+
+func checkOrderStatistics(t *testing.T, name string, tree *structures.RedBlackTree, sorted []int) {
+	if n := tree.Size(); n != len(sorted) {
+		t.Errorf("%s: expected size %d, got %d", name, len(sorted), n)
+	}
+	for k, v := range sorted {
+		node, b := tree.Select(k)
+		if !b || node.Val.(int) != v {
+			t.Errorf("%s: wrong select for k = %d", name, k)
+		}
+		if r := tree.Rank(v); r != sort.SearchInts(sorted, v) {
+			t.Errorf("%s: wrong rank for %d; expected %d, got %d", name, v, sort.SearchInts(sorted, v), r)
+		}
+	}
+	if _, b := tree.Select(len(sorted)); b {
+		t.Errorf("%s: select out of range should fail", name)
+	}
+	if r := tree.Rank(1000); r != len(sorted) {
+		t.Errorf("%s: wrong rank for 1000; expected %d, got %d", name, len(sorted), r)
+	}
+}
+
+func TestRedBlackTreeRange(t *testing.T) {
+	insertions := []int{16, 3, 7, 11, 9, 26, 18, 14, 15, 9, 18, 1}
+	sorted := []int{1, 3, 7, 9, 9, 11, 14, 15, 16, 18, 18, 26}
+	tree := structures.NewRedBlackTree(compareInt)
+	for _, num := range insertions {
+		tree.UnsafeInsert(num)
+	}
+	wrap := func(f func(v interface{}) (*structures.RBTreeNode, bool)) func(v int) (interface{}, bool) {
+		return func(v int) (interface{}, bool) {
+			node, b := f(v)
+			if !b {
+				return nil, false
+			}
+			return node.Val, true
+		}
+	}
+	checkRangeQueries(t, "RBTRange", sorted, func(lo, hi int, inclusiveLo, inclusiveHi bool) []interface{} {
+		return tree.Range(lo, hi, inclusiveLo, inclusiveHi)
+	}, wrap(tree.Floor), wrap(tree.Ceiling), wrap(tree.Lower), wrap(tree.Higher))
+}
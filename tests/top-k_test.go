@@ -0,0 +1,85 @@
+package tests
+
+import (
+	"math/rand"
+	"some-data-structures/generics"
+	"some-data-structures/structures"
+	"sort"
+	"testing"
+)
+
+func TestTopK(t *testing.T) {
+	// 1 basic operations
+	topK := structures.NewTopK(3, compareInt)
+	for _, num := range []int{5, 1, 9} {
+		if !topK.Offer(num) {
+			t.Errorf("TestTopK1: %d should be kept while the collector is not full", num)
+		}
+	}
+	if topK.Offer(1) || topK.Offer(0) {
+		t.Errorf("TestTopK1: values not larger than the worst one should not be kept")
+	}
+	if !topK.Offer(7) || topK.Len() != 3 || topK.K() != 3 {
+		t.Errorf("TestTopK1: 7 should be kept")
+	}
+	items := topK.Items()
+	got := make([]int, len(items))
+	for i, item := range items {
+		got[i] = item.(int)
+	}
+	checkInts(t, "TestTopK1", []int{9, 7, 5}, got)
+	if structures.NewTopK(0, compareInt) != nil {
+		t.Errorf("TestTopK1: k = 0 should be rejected")
+	}
+
+	// 2 workers collect partial results of a stream, which are merged afterwards
+	r := rand.New(rand.NewSource(16))
+	stream := make([]int, 10000)
+	for i := range stream {
+		stream[i] = r.Intn(100000)
+	}
+	k := 100
+	merged := generics.NewTopK(k, compareIntT)
+	for w := 0; w < 4; w ++ {
+		partial := generics.NewTopK(k, compareIntT)
+		for _, v := range stream[w * 2500:(w + 1) * 2500] {
+			partial.Offer(v)
+		}
+		merged.Merge(partial)
+		if partial.Len() != k {
+			t.Errorf("TestTopK2: the partial result should not be modified by Merge")
+		}
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(stream)))
+	checkInts(t, "TestTopK2", stream[:k], merged.Items())
+
+	// 3 fewer values than k
+	small := generics.NewTopK(10, compareIntT)
+	for _, v := range []int{3, 1, 2} {
+		small.Offer(v)
+	}
+	checkInts(t, "TestTopK3", []int{3, 2, 1}, small.Items())
+	checkInts(t, "TestTopK3", []int{3, 2, 1}, small.Items())
+	small.Merge(small)
+	checkInts(t, "TestTopK3", []int{3, 2, 1}, small.Items())
+
+	// 4 pointer items are returned as they are
+	vectors := generics.NewTopK(2, func(a, b *structures.Vector) int {
+		if a.Magnitude() > b.Magnitude() {
+			return 1
+		} else if a.Magnitude() < b.Magnitude() {
+			return -1
+		}
+		return 0
+	})
+	short, long := structures.NewVector([]float64{1, 1}), structures.NewVector([]float64{3, 4})
+	vectors.Offer(short)
+	if items := vectors.Items(); len(items) != 1 || items[0] != short {
+		t.Errorf("TestTopK4: expected the kept vector itself, got %v", items)
+	}
+	vectors.Offer(long)
+	vectors.Offer(structures.NewVector([]float64{0, 1}))
+	if items := vectors.Items(); len(items) != 2 || items[0] != long || items[1] != short {
+		t.Errorf("TestTopK4: expected [%v %v], got %v", long, short, items)
+	}
+}
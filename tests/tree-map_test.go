@@ -0,0 +1,113 @@
+package tests
+
+import (
+	"some-data-structures/generics"
+	"some-data-structures/structures"
+	"strconv"
+	"testing"
+)
+
+// the common API of TreeMap and BTreeMap
+type orderedMap interface {
+	Put(key, value interface{}) bool
+	Get(key interface{}) (interface{}, bool)
+	ContainsKey(key interface{}) bool
+	Remove(key interface{}) bool
+	Keys() []interface{}
+	Entries() []structures.Entry
+	Size() int
+}
+
+func TestTreeMap(t *testing.T) {
+	keys := []int{16, 3, 7, 11, 9, 26, 18, 14, 15, 1}
+	sorted := []int{1, 3, 7, 9, 11, 14, 15, 16, 18, 26}
+	maps := map[string]orderedMap{
+		"TreeMap": structures.NewTreeMap(compareInt),
+		"BTreeMap2": structures.NewBTreeMap(2, compareInt),
+		"BTreeMap3": structures.NewBTreeMap(3, compareInt),
+	}
+
+	for name, m := range maps {
+		// 1 put & get
+		for _, key := range keys {
+			if !m.Put(key, strconv.Itoa(key)) {
+				t.Errorf("TestTreeMap1 %s: %d should be a new key", name, key)
+			}
+		}
+		if m.Size() != len(keys) {
+			t.Errorf("TestTreeMap1 %s: expected size %d, got %d", name, len(keys), m.Size())
+		}
+		for _, key := range keys {
+			if v, b := m.Get(key); !b || v.(string) != strconv.Itoa(key) {
+				t.Errorf("TestTreeMap1 %s: expected %d, got %v", name, key, v)
+			}
+		}
+		if _, b := m.Get(100); b || m.ContainsKey(100) {
+			t.Errorf("TestTreeMap1 %s: 100 should not exist", name)
+		}
+
+		// 2 replace the values
+		if m.Put(7, "seven") || m.Size() != len(keys) {
+			t.Errorf("TestTreeMap2 %s: 7 should not be a new key", name)
+		}
+		if v, _ := m.Get(7); v.(string) != "seven" {
+			t.Errorf("TestTreeMap2 %s: expected seven, got %v", name, v)
+		}
+
+		// 3 keys & entries are ordered
+		for i, key := range m.Keys() {
+			if key.(int) != sorted[i] {
+				t.Errorf("TestTreeMap3 %s: expected %d, got %v", name, sorted[i], key)
+			}
+		}
+		for i, entry := range m.Entries() {
+			expected := strconv.Itoa(sorted[i])
+			if sorted[i] == 7 {
+				expected = "seven"
+			}
+			if entry.Key.(int) != sorted[i] || entry.Value.(string) != expected {
+				t.Errorf("TestTreeMap3 %s: expected %d:%s, got %v:%v", name, sorted[i], expected, entry.Key, entry.Value)
+			}
+		}
+
+		// 4 remove
+		if !m.Remove(11) || m.Remove(11) || m.ContainsKey(11) {
+			t.Errorf("TestTreeMap4 %s: fail to remove 11", name)
+		}
+		for _, key := range keys {
+			m.Remove(key)
+		}
+		if m.Size() != 0 || len(m.Keys()) != 0 {
+			t.Errorf("TestTreeMap4 %s: the map should be empty", name)
+		}
+	}
+
+	// 5 generic maps
+	tm := generics.NewTreeMap[string, int](func(a, b string) int {
+		if a > b {
+			return 1
+		} else if a == b {
+			return 0
+		}
+		return -1
+	})
+	for i, word := range []string{"pear", "apple", "fig", "apple"} {
+		tm.Put(word, i)
+	}
+	if v, _ := tm.Get("apple"); v != 3 || tm.Size() != 3 {
+		t.Errorf("TestTreeMap5: expected 3 keys with apple = 3, got %d keys with apple = %d", tm.Size(), v)
+	}
+	if words := tm.Keys(); words[0] != "apple" || words[1] != "fig" || words[2] != "pear" {
+		t.Errorf("TestTreeMap5: expected ordered keys, got %v", words)
+	}
+	bm := generics.NewBTreeMap[int, []int](2, compareIntT)
+	for i := 0; i < 100; i ++ {
+		bm.Put(i % 10, []int{i})
+	}
+	if v, b := bm.Get(4); !b || v[0] != 94 || bm.Size() != 10 {
+		t.Errorf("TestTreeMap5: expected 10 keys with 4 = [94], got %d keys with 4 = %v", bm.Size(), v)
+	}
+	if generics.NewBTreeMap[int, int](1, compareIntT) != nil {
+		t.Errorf("TestTreeMap5: t = 1 should be rejected")
+	}
+}